@@ -6,13 +6,16 @@ package acctest
 import (
 	"context"
 	"fmt"
+	"log"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -446,6 +449,13 @@ func init() {
 		os.Setenv("IBMCLOUD_BLUEMIX_GO_TRACE", "true")
 	}
 
+	if mode := os.Getenv("IBMCLOUD_CASSETTE_MODE"); mode != "" {
+		cassetteErr = useCassette(mode, conns.EnvFallBack([]string{"IBMCLOUD_CASSETTE_FILE"}, DefaultCassetteFile))
+		if cassetteErr != nil {
+			log.Printf("[ERROR] %s", cassetteErr)
+		}
+	}
+
 	IamIdentityAssignmentTargetAccountId = os.Getenv("IAM_IDENTITY_ASSIGNMENT_TARGET_ACCOUNT")
 
 	ProjectsConfigApiKey = os.Getenv("IBM_PROJECTS_CONFIG_APIKEY")
@@ -2107,6 +2117,9 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func TestAccPreCheck(t *testing.T) {
+	if cassetteErr != nil {
+		t.Fatalf("Unable to use the cassette of IBMCLOUD_CASSETTE_MODE: %s", cassetteErr)
+	}
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -2427,10 +2440,20 @@ func TestAccPreCheckPartnerCenterSell(t *testing.T) {
 	}
 }
 
-func TestAccProviderFactories() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		ProviderName:          func() (*schema.Provider, error) { return provider.Provider(), nil },
-		ProviderNameAlternate: func() (*schema.Provider, error) { return provider.Provider(), nil },
+// TestAccProviderFactories returns the factories of the provider server that
+// main serves, the mux of the SDKv2 and plugin framework providers, for the
+// ProtoV5ProviderFactories of the resource.TestCase.
+func TestAccProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	factory := func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := provider.ProtoV5ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	}
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		ProviderName:          factory,
+		ProviderNameAlternate: factory,
 	}
 }
 
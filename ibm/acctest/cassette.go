@@ -0,0 +1,452 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// Cassette modes, set with the IBMCLOUD_CASSETTE_MODE environment variable.
+const (
+	// CassetteRecord records the API traffic of the acceptance tests to the cassette
+	CassetteRecord = "record"
+	// CassetteReplay serves the API traffic of the acceptance tests from the cassette
+	CassetteReplay = "replay"
+)
+
+// DefaultCassetteFile is the cassette of the acceptance tests of a package,
+// relative to the package directory, unless IBMCLOUD_CASSETTE_FILE is set.
+const DefaultCassetteFile = "testdata/cassette.jsonl"
+
+const redacted = "REDACTED"
+
+// secretFields are the JSON and form fields whose values are scrubbed from
+// the cassette. The values of the fields nested in credentials are scrubbed too.
+var secretFields = map[string]bool{
+	"access_token": true, "refresh_token": true, "delegated_refresh_token": true, "token": true, "iam_token": true,
+	"apikey": true, "api_key": true, "password": true, "passcode": true, "passphrase": true,
+	"client_secret": true, "private_key": true, "payload": true, "credentials": true,
+}
+
+// secretHeaders are the headers that are left out of the cassette.
+var secretHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Auth-Token", "X-Auth-Refresh-Token", "X-Auth-User-Token", "Refresh-Token", "X-Api-Key"}
+
+// farFuture is the expiry of the access tokens of the cassette, so that they
+// are not refreshed during a replay.
+const farFuture = 4102444800
+
+// interaction is a request of the cassette and its response.
+type interaction struct {
+	Request struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header,omitempty"`
+		Body   string      `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header,omitempty"`
+		Body       string      `json:"body,omitempty"`
+	} `json:"response"`
+
+	replayed bool
+}
+
+// cassette records the API traffic of an acceptance test run to a JSON lines
+// file, one interaction per line, or replays it from the file.
+type cassette struct {
+	path string
+	mode string
+
+	mu           sync.Mutex
+	interactions []*interaction
+
+	// names maps the generated names of the recording to the ones of the
+	// replay, and replayNames the other way round, see learnNames
+	names       map[string]string
+	replayNames map[string]string
+}
+
+// cassetteErr is the error of the cassette set up in init, reported by
+// TestAccPreCheck.
+var cassetteErr error
+
+// replayCredentials stand in for the credentials the acceptance tests check
+// for, as a replay sends no request to IBM Cloud.
+var replayCredentials = []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"}
+
+// useCassette records the API traffic of the acceptance tests to, or replays
+// it from, the cassette at path.
+func useCassette(mode, path string) error {
+	c, err := newCassette(path, mode)
+	if err != nil {
+		return err
+	}
+	if mode == CassetteReplay {
+		for _, env := range replayCredentials {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, redacted)
+			}
+		}
+	}
+	conns.TransportMiddleware = c.transport
+	log.Printf("[INFO] Using cassette %s in %s mode", path, mode)
+	return nil
+}
+
+func newCassette(path, mode string) (*cassette, error) {
+	c := &cassette{path: path, mode: mode, names: map[string]string{}, replayNames: map[string]string{}}
+	switch mode {
+	case CassetteRecord:
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := file.Close(); err != nil {
+			return nil, err
+		}
+	case CassetteReplay:
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(nil, 64<<20)
+		for scanner.Scan() {
+			i := &interaction{}
+			if err := json.Unmarshal(scanner.Bytes(), i); err != nil {
+				return nil, fmt.Errorf("[ERROR] Invalid cassette %s: %s", path, err)
+			}
+			c.interactions = append(c.interactions, i)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("[ERROR] Unknown cassette mode %q, expected %s or %s", mode, CassetteRecord, CassetteReplay)
+	}
+	return c, nil
+}
+
+// transport returns the middleware of the session transports.
+func (c *cassette) transport(base http.RoundTripper) http.RoundTripper {
+	return &cassetteTransport{base: base, cassette: c}
+}
+
+type cassetteTransport struct {
+	base     http.RoundTripper
+	cassette *cassette
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if t.cassette.mode == CassetteReplay {
+		return t.cassette.replay(req, body)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return resp, t.cassette.record(req, body, resp, respBody)
+}
+
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+func (c *cassette) record(req *http.Request, body []byte, resp *http.Response, respBody []byte) error {
+	i := &interaction{}
+	i.Request.Method = req.Method
+	i.Request.URL = scrubURL(req.URL)
+	i.Request.Header = scrubHeader(req.Header)
+	i.Request.Body = scrubBody(req.Header.Get("Content-Type"), body)
+	i.Response.StatusCode = resp.StatusCode
+	i.Response.Header = scrubHeader(resp.Header)
+	i.Response.Body = scrubBody(resp.Header.Get("Content-Type"), respBody)
+
+	line, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// The file is opened for each interaction, as nothing closes it at the
+	// end of the run
+	file, err := os.OpenFile(c.path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// replay answers req with the first interaction not replayed yet for the same
+// method, URL and body, or failing that for the same method and URL. Once all
+// of them are replayed, the last one answers the following requests, which
+// absorbs the variations in the number of polls between runs.
+//
+// The names generated by the tests, such as tf-vpc-42, differ from a run to
+// the next. When no interaction has the URL of req, the URLs and bodies are
+// compared regardless of their numbers. The names of the recording are then
+// replaced by the ones of the replay in the following requests and responses.
+func (c *cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	method := req.Method
+	url := c.recordedNames(scrubURL(req.URL))
+	scrubbed := c.recordedNames(scrubBody(req.Header.Get("Content-Type"), body))
+
+	i := c.find(method, url, scrubbed, func(recorded, replayed string) bool { return recorded == replayed })
+	if i == nil {
+		i = c.find(method, url, scrubbed, sameMasked)
+	}
+	if i == nil {
+		return nil, fmt.Errorf("[ERROR] No interaction of cassette %s for %s %s, record it again", c.path, method, url)
+	}
+	i.replayed = true
+	c.learnNames(i.Request.URL, url)
+	c.learnNames(i.Request.Body, scrubbed)
+	respBody := replaceNames(i.Response.Body, c.names)
+
+	header := i.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	// The body may have changed length when it was scrubbed
+	header.Del("Content-Length")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+		StatusCode:    i.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// find returns the interaction answering the request, the URLs and bodies
+// being compared with same.
+func (c *cassette) find(method, url, body string, same func(recorded, replayed string) bool) *interaction {
+	var sameBody, sameURL, last *interaction
+	for _, i := range c.interactions {
+		if i.Request.Method != method || !same(i.Request.URL, url) {
+			continue
+		}
+		last = i
+		if i.replayed {
+			continue
+		}
+		if sameBody == nil && same(i.Request.Body, body) {
+			sameBody = i
+		}
+		if sameURL == nil {
+			sameURL = i
+		}
+	}
+	switch {
+	case sameBody != nil:
+		return sameBody
+	case sameURL != nil:
+		return sameURL
+	}
+	return last
+}
+
+var (
+	// nameTokens are the words of the URLs and bodies that can be names
+	nameTokens = regexp.MustCompile(`[A-Za-z0-9_.-]+`)
+	numbers    = regexp.MustCompile(`[0-9]+`)
+)
+
+// sameMasked reports whether recorded and replayed only differ by their
+// numbers.
+func sameMasked(recorded, replayed string) bool {
+	return numbers.ReplaceAllString(recorded, "0") == numbers.ReplaceAllString(replayed, "0")
+}
+
+// learnNames pairs the generated names of recorded, a URL or body of the
+// cassette, with the ones of replayed, which only differs by its numbers. A
+// generated name is a word with letters and numbers, such as tf-vpc-42.
+func (c *cassette) learnNames(recorded, replayed string) {
+	if !sameMasked(recorded, replayed) {
+		return
+	}
+	recordedWords, replayedWords := nameTokens.FindAllString(recorded, -1), nameTokens.FindAllString(replayed, -1)
+	if len(recordedWords) != len(replayedWords) {
+		return
+	}
+	for n, word := range recordedWords {
+		if word == replayedWords[n] || numbers.ReplaceAllString(word, "") == "" {
+			continue
+		}
+		if _, ok := c.names[word]; !ok {
+			c.names[word] = replayedWords[n]
+			c.replayNames[replayedWords[n]] = word
+		}
+	}
+}
+
+// recordedNames replaces the generated names of the replay in s by the ones of
+// the recording.
+func (c *cassette) recordedNames(s string) string {
+	return replaceNames(s, c.replayNames)
+}
+
+func replaceNames(s string, names map[string]string) string {
+	if len(names) == 0 {
+		return s
+	}
+	return nameTokens.ReplaceAllStringFunc(s, func(word string) string {
+		if name, ok := names[word]; ok {
+			return name
+		}
+		return word
+	})
+}
+
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	query := scrubbed.Query()
+	for key := range query {
+		if secretFields[strings.ToLower(key)] {
+			query.Set(key, redacted)
+		}
+	}
+	scrubbed.RawQuery = query.Encode()
+	scrubbed.User = nil
+	return scrubbed.String()
+}
+
+func scrubHeader(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	for _, h := range secretHeaders {
+		scrubbed.Del(h)
+	}
+	return scrubbed
+}
+
+// scrubBody replaces the values of the secret fields of a JSON or form body.
+// The access tokens issued by IAM are replaced by unsigned tokens that keep
+// their claims, which the provider reads the account and user from.
+func scrubBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for key := range form {
+				if secretFields[strings.ToLower(key)] {
+					form.Set(key, redacted)
+				}
+			}
+			return form.Encode()
+		}
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+	// Bodies without secrets are kept as they are
+	changed := false
+	value = scrubJSON(value, false, &changed)
+	if !changed {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+func scrubJSON(value interface{}, secret bool, changed *bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			name := strings.ToLower(key)
+			if token, ok := field.(string); ok && name == "access_token" {
+				v[key], *changed = unsignedToken(token), true
+				continue
+			}
+			if name == "expiration" && v["access_token"] != nil {
+				v[key], *changed = farFuture, true
+				continue
+			}
+			v[key] = scrubJSON(field, secret || secretFields[name], changed)
+		}
+		return v
+	case []interface{}:
+		for n, item := range v {
+			v[n] = scrubJSON(item, secret, changed)
+		}
+		return v
+	case string:
+		if secret {
+			*changed = true
+			return redacted
+		}
+	}
+	return value
+}
+
+// unsignedToken returns a copy of a JWT that expires far in the future and
+// carries no signature, or REDACTED when token is not a JWT.
+func unsignedToken(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return redacted
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return redacted
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return redacted
+	}
+	claims["exp"] = farFuture
+	payload, _ = json.Marshal(claims)
+	return parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString([]byte(redacted))
+}
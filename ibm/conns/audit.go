@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// operationKey is the context key of the resource operation an API call is made for.
+type operationKey struct{}
+
+type operation struct {
+	resourceType string
+	name         string
+}
+
+// WithOperation returns a context that attributes the API calls made with it
+// to an operation (create, read, update, delete...) of a resource type.
+func WithOperation(ctx context.Context, resourceType, operationName string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation{resourceType: resourceType, name: operationName})
+}
+
+func operationFromContext(ctx context.Context) (operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(operation)
+	return op, ok
+}
+
+// AuditRecord is a line of the audit log, written for every API call that may
+// change a resource.
+type AuditRecord struct {
+	Timestamp     time.Time       `json:"timestamp"`
+	ResourceType  string          `json:"resource_type,omitempty"`
+	Operation     string          `json:"operation,omitempty"`
+	Method        string          `json:"method"`
+	URL           string          `json:"url"`
+	StatusCode    int             `json:"status_code,omitempty"`
+	RequestID     string          `json:"request_id,omitempty"`
+	TransactionID string          `json:"transaction_id,omitempty"`
+	RequestBody   json.RawMessage `json:"request_body,omitempty"`
+	Error         string          `json:"error,omitempty"`
+}
+
+// auditLog appends AuditRecords to a JSON lines file.
+type auditLog struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+}
+
+// auditLogs are the open audit logs by path, shared by the sessions of the
+// provider configurations that write to the same file.
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = map[string]*auditLog{}
+)
+
+// openAuditLog opens the audit log at path for appending, creating it if needed.
+func openAuditLog(path string) (*auditLog, error) {
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+	if l, ok := auditLogs[path]; ok {
+		return l, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error opening audit_log_path %s: %s", path, err)
+	}
+	l := &auditLog{path: path, file: file}
+	auditLogs[path] = l
+	return l, nil
+}
+
+func (l *auditLog) write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.file.Write(append(line, '\n'))
+	return err
+}
+
+// auditTransport writes a record to the audit log for every request that may
+// change a resource, see readOnlyAllowed. The records of the requests made
+// without the context of a resource operation, see WithOperation, have no
+// resource type nor operation.
+type auditTransport struct {
+	base http.RoundTripper
+	log  *auditLog
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if readOnlyAllowed(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	record := &AuditRecord{
+		Timestamp: time.Now().UTC(),
+		Method:    req.Method,
+		URL:       redactURL(req.URL),
+		RequestID: req.Header.Get("X-Request-Id"),
+	}
+	if op, ok := operationFromContext(req.Context()); ok {
+		record.ResourceType, record.Operation = op.resourceType, op.name
+	}
+	body, err := peekBody(req)
+	if err != nil {
+		return nil, err
+	}
+	record.RequestBody = redactBody(req.Header.Get("Content-Type"), body)
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.StatusCode = resp.StatusCode
+		if id := resp.Header.Get("X-Request-Id"); id != "" {
+			record.RequestID = id
+		}
+		record.TransactionID = resp.Header.Get("Transaction-Id")
+	}
+	if record.TransactionID == "" {
+		record.TransactionID = req.Header.Get("Transaction-Id")
+	}
+	// The request was sent, failing it now would lose track of its outcome
+	if werr := t.log.write(record); werr != nil {
+		log.Printf("[ERROR] Error writing %s %s to audit_log_path %s: %s", record.Method, record.URL, t.log.path, werr)
+	}
+	return resp, err
+}
+
+// peekBody returns the body of req, which is left readable for the base transport.
+func peekBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
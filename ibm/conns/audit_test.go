@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func TestAuditTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("Transaction-Id", "txn-1")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := openAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := &Session{Transport: &http.Transport{}, auditLog: auditLog}
+	client := &http.Client{Transport: sess.serviceTransport("iam")}
+
+	ctx := WithOperation(context.Background(), "ibm_iam_service_id", "create")
+	body := `{"name":"ci","apikey":{"apikey":"secret-value","name":"key"},"tags":["a"]}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/serviceids?token=abc", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// Neither reads nor token exchanges are recorded
+	for _, path := range []string{"/v1/serviceids", "/identity/token"} {
+		method := http.MethodGet
+		if path == "/identity/token" {
+			method = http.MethodPost
+		}
+		req, _ := http.NewRequest(method, server.URL+path, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 record, got %d: %s", len(lines), content)
+	}
+	var record AuditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.ResourceType != "ibm_iam_service_id" || record.Operation != "create" || record.Method != http.MethodPost {
+		t.Fatalf("unexpected record %+v", record)
+	}
+	if record.StatusCode != http.StatusCreated || record.RequestID != "req-1" || record.TransactionID != "txn-1" {
+		t.Fatalf("unexpected record %+v", record)
+	}
+	if !strings.HasSuffix(record.URL, "/v1/serviceids?token=REDACTED") {
+		t.Fatalf("expected the token to be redacted from the URL, got %s", record.URL)
+	}
+	if expected := `{"apikey":"REDACTED","name":"ci","tags":["a"]}`; string(record.RequestBody) != expected {
+		t.Fatalf("expected the body %s, got %s", expected, record.RequestBody)
+	}
+}
+
+func TestAuditTransportWithoutContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := openAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := &Session{Transport: &http.Transport{}, auditLog: auditLog}
+	bmxSess := &bxsession.Session{Config: &bluemix.Config{
+		HTTPClient: &http.Client{Transport: sess.serviceTransport(BluemixServiceName)},
+	}}
+	// bluemix-go makes its requests without a context
+	post := func(client *http.Client, path string) {
+		resp, err := client.Post(server.URL+path, "application/json", strings.NewReader(`{"name":"ci"}`))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		resp.Body.Close()
+	}
+
+	// The requests of concurrent operations, and the ones made outside of them
+	var wg sync.WaitGroup
+	for _, name := range []string{"ibm_container_dedicated_host", "ibm_container_dedicated_host_pool"} {
+		opSess := BluemixSessionWithContext(WithOperation(context.Background(), name, "create"), bmxSess)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			post(opSess.Config.HTTPClient, "/v2/"+name)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		post(bmxSess.Config.HTTPClient, "/v2/none")
+	}()
+	wg.Wait()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 records, got %d: %s", len(lines), content)
+	}
+	expected := map[string]string{
+		"/v2/ibm_container_dedicated_host":      "ibm_container_dedicated_host",
+		"/v2/ibm_container_dedicated_host_pool": "ibm_container_dedicated_host_pool",
+		"/v2/none":                              "",
+	}
+	for _, line := range lines {
+		var record AuditRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatal(err)
+		}
+		resourceType := expected[record.URL[len(server.URL):]]
+		operation := "create"
+		if resourceType == "" {
+			operation = ""
+		}
+		if record.ResourceType != resourceType || record.Operation != operation {
+			t.Fatalf("expected the record of %s to be attributed to %q, got %+v", record.URL, resourceType, record)
+		}
+	}
+	if _, ok := bmxSess.Config.HTTPClient.Transport.(*operationTransport); ok {
+		t.Fatal("expected the client of the session to be left unchanged")
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The compute resources whose identity the provider can authenticate with,
+// see Config.ComputeResourceAuth.
+const (
+	// ComputeResourceAuthContainer exchanges the service account token that
+	// IKS and Red Hat OpenShift project into the pods.
+	ComputeResourceAuthContainer = "container"
+	// ComputeResourceAuthVPCInstance exchanges the instance identity token of
+	// the metadata service of a VPC virtual server instance.
+	ComputeResourceAuthVPCInstance = "vpc_instance"
+)
+
+// ComputeResourceAuthTypes are the allowed values of compute_resource_auth.
+var ComputeResourceAuthTypes = []string{ComputeResourceAuthContainer, ComputeResourceAuthVPCInstance}
+
+// computeResourceAuthenticator is a go-sdk-core authenticator that gets the
+// IAM token of a trusted profile with the token of a compute resource. It
+// refreshes the IAM token before it expires.
+type computeResourceAuthenticator interface {
+	core.Authenticator
+	GetToken() (string, error)
+}
+
+// computeResourceAuthenticator returns the authenticator of the compute
+// resource set in ComputeResourceAuth. iamURL is the IAM endpoint the
+// container tokens are exchanged with, the VPC instance tokens are exchanged
+// by the metadata service at VPCMetadataURL. The container tokens are
+// exchanged with iamClient, nil for the default client.
+func (c *Config) computeResourceAuthenticator(iamURL string, iamClient *http.Client) (computeResourceAuthenticator, error) {
+	switch c.ComputeResourceAuth {
+	case ComputeResourceAuthContainer:
+		if c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "" {
+			return nil, fmt.Errorf("[ERROR] iam_profile_id or iam_profile_name must be provided with compute_resource_auth %q", c.ComputeResourceAuth)
+		}
+		authenticator, err := core.NewContainerAuthenticatorBuilder().
+			SetCRTokenFilename(c.CRTokenFilename).
+			SetIAMProfileID(c.IAMTrustedProfileID).
+			SetIAMProfileName(c.IAMTrustedProfileName).
+			SetURL(iamURL).
+			SetClient(iamClient).
+			Build()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error configuring the container authenticator: %s", err)
+		}
+		return authenticator, nil
+	case ComputeResourceAuthVPCInstance:
+		if c.IAMTrustedProfileName != "" {
+			return nil, fmt.Errorf("[ERROR] iam_profile_name is not supported with compute_resource_auth %q, use iam_profile_id", c.ComputeResourceAuth)
+		}
+		// Without a profile ID the metadata service uses the trusted profile
+		// linked to the instance.
+		authenticator, err := core.NewVpcInstanceAuthenticatorBuilder().
+			SetIAMProfileID(c.IAMTrustedProfileID).
+			SetURL(c.VPCMetadataURL).
+			Build()
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error configuring the VPC instance authenticator: %s", err)
+		}
+		return authenticator, nil
+	default:
+		return nil, fmt.Errorf("[ERROR] Unsupported compute_resource_auth %q: expected one of %s", c.ComputeResourceAuth, strings.Join(ComputeResourceAuthTypes, ", "))
+	}
+}
+
+// computeResourceToken keeps track of the IAM tokens issued by the compute
+// resource authenticator of the session: the first one, which the bluemix-go
+// and Key Protect clients are configured with, and the current one.
+type computeResourceToken struct {
+	authenticator computeResourceAuthenticator
+
+	mu      sync.Mutex
+	first   string
+	current string
+}
+
+func newComputeResourceToken(authenticator computeResourceAuthenticator) *computeResourceToken {
+	return &computeResourceToken{authenticator: authenticator}
+}
+
+// token returns the current IAM token, refreshed by the authenticator when it
+// is about to expire.
+func (t *computeResourceToken) token() (string, error) {
+	token, err := t.authenticator.GetToken()
+	if err != nil {
+		return "", err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.first == "" {
+		t.first = token
+	}
+	t.current = token
+	return token, nil
+}
+
+func (t *computeResourceToken) isIssued(token string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return token != "" && (token == t.first || token == t.current)
+}
+
+// computeResourceAuthTransport replaces the IAM token of the requests
+// authenticated with a token issued by the compute resource authenticator
+// with the current one. Unlike the go-sdk-core based clients, the bluemix-go
+// and Key Protect clients keep the token they were configured with, which
+// expires during long applies. Other Authorization headers are left as is.
+type computeResourceAuthTransport struct {
+	base  http.RoundTripper
+	token *computeResourceToken
+}
+
+func (t *computeResourceAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	authorization := req.Header.Get("Authorization")
+	bearer := strings.TrimPrefix(authorization, "Bearer ")
+	if bearer != authorization && t.token.isIssued(bearer) {
+		token, err := t.token.token()
+		if err != nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, fmt.Errorf("[ERROR] Error refreshing the IAM token of the compute resource: %s", err)
+		}
+		if token != bearer {
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeComputeResourceAuthenticator issues the tokens in turn, as if each one
+// expired before the next call.
+type fakeComputeResourceAuthenticator struct {
+	tokens []string
+	calls  int
+}
+
+func (a *fakeComputeResourceAuthenticator) AuthenticationType() string { return "fake" }
+
+func (a *fakeComputeResourceAuthenticator) Authenticate(req *http.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *fakeComputeResourceAuthenticator) Validate() error { return nil }
+
+func (a *fakeComputeResourceAuthenticator) GetToken() (string, error) {
+	token := a.tokens[a.calls%len(a.tokens)]
+	a.calls++
+	return token, nil
+}
+
+func TestComputeResourceAuthTransport(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	token := newComputeResourceToken(&fakeComputeResourceAuthenticator{tokens: []string{"first", "second"}})
+	issued, err := token.token()
+	if err != nil || issued != "first" {
+		t.Fatalf("expected the first token, got %q, %v", issued, err)
+	}
+	sess := &Session{Transport: &http.Transport{}, computeResourceAuth: token}
+	client := &http.Client{Transport: sess.serviceTransport(BluemixServiceName)}
+
+	for _, authorization := range []string{"Bearer first", "Bearer other", "Basic first", ""} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/v2/accounts", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request with %q failed: %s", authorization, err)
+		}
+		resp.Body.Close()
+		if req.Header.Get("Authorization") != authorization {
+			t.Fatalf("the transport must not modify the request, got %q", req.Header.Get("Authorization"))
+		}
+	}
+
+	expected := []string{"Bearer second", "Bearer other", "Basic first", ""}
+	if strings.Join(authorizations, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the authorizations %q, got %q", expected, authorizations)
+	}
+	if !token.isIssued("second") {
+		t.Fatalf("the refreshed token should be tracked as issued")
+	}
+}
+
+func TestComputeResourceTokenIssued(t *testing.T) {
+	token := newComputeResourceToken(&fakeComputeResourceAuthenticator{tokens: []string{"first", "second", "third"}})
+	for i := 0; i < 3; i++ {
+		if _, err := token.token(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The tokens refreshed since are forgotten, the first one is kept for the
+	// clients configured with it
+	for issued, expected := range map[string]bool{"first": true, "second": false, "third": true, "": false} {
+		if token.isIssued(issued) != expected {
+			t.Errorf("expected %q to be issued: %t", issued, expected)
+		}
+	}
+}
+
+func TestComputeResourceAuthenticatorVPCInstance(t *testing.T) {
+	claims, _ := json.Marshal(map[string]int64{"iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix()})
+	iamToken := strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)),
+		base64.RawURLEncoding.EncodeToString(claims),
+		base64.RawURLEncoding.EncodeToString([]byte("signature")),
+	}, ".")
+
+	var iamTokenRequests int
+	var profileRequest string
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/instance_identity/v1/token":
+			if r.Method != http.MethodPut || r.Header.Get("Metadata-Flavor") != "ibm" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"instance-identity-token","expires_in":300}`)
+		case "/instance_identity/v1/iam_token":
+			if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer instance-identity-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			profileRequest = string(body)
+			iamTokenRequests++
+			fmt.Fprintf(w, `{"access_token":%q,"expires_in":3600}`, iamToken)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer metadata.Close()
+
+	c := &Config{
+		ComputeResourceAuth: ComputeResourceAuthVPCInstance,
+		IAMTrustedProfileID: "Profile-0a1b2c3d",
+		VPCMetadataURL:      metadata.URL,
+	}
+	authenticator, err := c.computeResourceAuthenticator("", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		token, err := authenticator.GetToken()
+		if err != nil {
+			t.Fatalf("unexpected error getting the token: %s", err)
+		}
+		if token != iamToken {
+			t.Fatalf("expected the IAM token of the metadata service, got %q", token)
+		}
+	}
+	if iamTokenRequests != 1 {
+		t.Fatalf("expected the IAM token to be requested once and cached, got %d requests", iamTokenRequests)
+	}
+	if !strings.Contains(profileRequest, "Profile-0a1b2c3d") {
+		t.Fatalf("expected the trusted profile in the IAM token request, got %s", profileRequest)
+	}
+}
+
+func TestComputeResourceAuthenticatorErrors(t *testing.T) {
+	for _, c := range []*Config{
+		{ComputeResourceAuth: ComputeResourceAuthContainer},
+		{ComputeResourceAuth: ComputeResourceAuthVPCInstance, IAMTrustedProfileName: "runner"},
+		{ComputeResourceAuth: "code_engine", IAMTrustedProfileID: "Profile-0a1b2c3d"},
+	} {
+		if _, err := c.computeResourceAuthenticator("https://iam.cloud.ibm.com", nil); err == nil {
+			t.Fatalf("expected an error with %+v", c)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+// ServiceEndpointKeys maps the arguments of the provider endpoints block to
+// the endpoint keys they override. The keys are the names of the environment
+// variables, and of the endpoints file entries, for the same endpoints.
+var ServiceEndpointKeys = map[string]string{
+	"api_gateway":                "IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"app_configuration":          "IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"appid":                      "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"backup_recovery":            "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT",
+	"backup_recovery_connector":  "IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"cloud_shell":                "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"code_engine":                "IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"databases":                  "IBMCLOUD_DATABASES_API_ENDPOINT",
+	"db2":                        "IBMCLOUD_DB2_API_ENDPOINT",
+	"directlink":                 "IBMCLOUD_DL_API_ENDPOINT",
+	"directlink_provider":        "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"global_catalog":             "IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT",
+	"global_search":              "IBMCLOUD_GS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"key_protect":                "IBMCLOUD_KP_API_ENDPOINT",
+	"logs":                       "IBMCLOUD_LOGS_API_ENDPOINT",
+	"metrics_routing":            "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"mqcloud":                    "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"partner_center_sell":        "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"power":                      "IBMCLOUD_PI_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"project":                    "IBMCLOUD_PROJECT_API_ENDPOINT",
+	"push_notifications":         "IBMCLOUD_PUSH_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"satellite_link":             "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"scc":                        "IBMCLOUD_SCC_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"tekton_pipeline":            "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"toolchain":                  "IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"usage_reports":              "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"vmware":                     "IBMCLOUD_VMWARE_URL",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// endpointFallBack resolves the endpoint of a service client. The provider
+// endpoints block has the highest precedence, followed by the environment
+// variables named by keys and finally defaultValue, which callers derive from
+// the endpoints file (see fileFallBack) or the built-in endpoints.
+func (s *Session) endpointFallBack(keys []string, defaultValue string) string {
+	for _, k := range keys {
+		if v := s.Endpoints[k]; v != "" {
+			return v
+		}
+	}
+	return EnvFallBack(keys, defaultValue)
+}
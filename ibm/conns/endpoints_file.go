@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"gopkg.in/yaml.v3"
+)
+
+// EndpointsFileKeys are the service keys accepted in the endpoints file.
+var EndpointsFileKeys = []string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_CF_API_ENDPOINT",
+	"IBMCLOUD_CIS_API_ENDPOINT",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+	"IBMCLOUD_CR_API_ENDPOINT",
+	"IBMCLOUD_CSE_ENDPOINT",
+	"IBMCLOUD_CS_API_ENDPOINT",
+	"IBMCLOUD_DL_API_ENDPOINT",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_GT_API_ENDPOINT",
+	"IBMCLOUD_HPCS_API_ENDPOINT",
+	"IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"IBMCLOUD_IAM_API_ENDPOINT",
+	"IBMCLOUD_ICD_API_ENDPOINT",
+	"IBMCLOUD_IS_NG_API_ENDPOINT",
+	"IBMCLOUD_KP_API_ENDPOINT",
+	"IBMCLOUD_LOGS_API_ENDPOINT",
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_MCCP_API_ENDPOINT",
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"IBMCLOUD_PROJECT_API_ENDPOINT",
+	"IBMCLOUD_PUSH_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"IBMCLOUD_SAT_API_ENDPOINT",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"IBMCLOUD_TG_API_ENDPOINT",
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"IBMCLOUD_UAA_ENDPOINT",
+	"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+}
+
+// endpointsFileVisibilities are the visibilities an endpoint can be declared for.
+var endpointsFileVisibilities = []string{"public", "private"}
+
+// endpointsFiles caches the parsed endpoints files, keyed by path, so that a
+// file is read once per plugin process.
+var endpointsFiles sync.Map
+
+type endpointsFile struct {
+	fileMap  map[string]interface{}
+	warnings []string
+}
+
+// LoadEndpointsFile reads, validates and caches the endpoints file at path. The
+// file is YAML when its extension is .yaml or .yml, JSON otherwise. Malformed
+// files are reported as errors; unknown service keys and visibilities are
+// returned as warnings, with the closest known key when the key looks like a
+// typo. An empty path returns a nil map.
+func LoadEndpointsFile(path string) (map[string]interface{}, []string, error) {
+	if path == "" {
+		return nil, nil, nil
+	}
+	if f, ok := endpointsFiles.Load(path); ok {
+		return f.(*endpointsFile).fileMap, f.(*endpointsFile).warnings, nil
+	}
+
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Unable to read endpoints file %s: %s", path, err)
+	}
+	var fileMap map[string]interface{}
+	if isYAMLFile(path) {
+		err = yaml.Unmarshal(bytes, &fileMap)
+	} else {
+		err = json.Unmarshal(bytes, &fileMap)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Unable to parse endpoints file %s: %s", path, err)
+	}
+	warnings, err := validateEndpointsFile(fileMap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %s", path, err)
+	}
+
+	f, _ := endpointsFiles.LoadOrStore(path, &endpointsFile{fileMap: fileMap, warnings: warnings})
+	return f.(*endpointsFile).fileMap, f.(*endpointsFile).warnings, nil
+}
+
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// validateEndpointsFile checks that every entry of fileMap maps visibilities to
+// regions to URLs, the shape fileFallBack expects.
+func validateEndpointsFile(fileMap map[string]interface{}) ([]string, error) {
+	keys := make([]string, 0, len(fileMap))
+	for key := range fileMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var warnings []string
+	for _, key := range keys {
+		if !contains(EndpointsFileKeys, key) {
+			warning := fmt.Sprintf("Unknown key %s in endpoints file, it is ignored", key)
+			if suggestion := closestEndpointsFileKey(key); suggestion != "" {
+				warning = fmt.Sprintf("%s. Did you mean %s?", warning, suggestion)
+			}
+			warnings = append(warnings, warning)
+		}
+		visibilities, ok := fileMap[key].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s must map visibilities (public, private) to regional endpoints", key)
+		}
+		for visibility, v := range visibilities {
+			if !contains(endpointsFileVisibilities, visibility) {
+				warnings = append(warnings, fmt.Sprintf("Unknown visibility %s for %s in endpoints file, expected one of %s", visibility, key, strings.Join(endpointsFileVisibilities, ", ")))
+			}
+			regions, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s.%s must map regions to endpoint URLs", key, visibility)
+			}
+			for region, url := range regions {
+				if _, ok := url.(string); !ok {
+					return nil, fmt.Errorf("%s.%s.%s must be an endpoint URL", key, visibility, region)
+				}
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// closestEndpointsFileKey returns the known key closest to key, if it is near
+// enough to be a typo.
+func closestEndpointsFileKey(key string) string {
+	closest, distance := "", 4
+	for _, k := range EndpointsFileKeys {
+		if d := levenshtein(strings.ToUpper(key), k); d < distance {
+			closest, distance = k, d
+		}
+	}
+	return closest
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// bluemixEndpointLocator resolves the endpoints of the bluemix-go clients
+// from the endpoints file parsed by the provider, so that bluemix-go neither
+// reads the file again, which it only can as JSON, nor needs it in the process
+// environment. The path of the file stays in the session config for the
+// callers of FileFallBack. As bluemix-go does, the service environment
+// variables take precedence over the file, and the defaults of the region and
+// visibility apply to the services the file does not declare.
+type bluemixEndpointLocator struct {
+	endpoints.EndpointLocator
+	fileMap    map[string]interface{}
+	region     string
+	visibility string
+}
+
+// newBluemixEndpointLocator returns the endpoint locator of the bluemix-go
+// session, nil to let bluemix-go use its own when no endpoints file is
+// configured.
+func newBluemixEndpointLocator(fileMap map[string]interface{}, region, visibility string) endpoints.EndpointLocator {
+	if fileMap == nil {
+		return nil
+	}
+	// The defaults of the bluemix-go session
+	if region == "" {
+		region = EnvFallBack([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south")
+	}
+	if visibility == "" {
+		visibility = EnvFallBack([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public")
+	}
+	return &bluemixEndpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(region, visibility, ""),
+		fileMap:         fileMap,
+		region:          region,
+		visibility:      visibility,
+	}
+}
+
+func (l *bluemixEndpointLocator) locate(key string, defaultEndpoint func() (string, error)) (string, error) {
+	if os.Getenv(key) == "" && l.visibility != "public-and-private" {
+		if url := fileFallBack(l.fileMap, l.visibility, key, l.region, ""); url != "" {
+			return url, nil
+		}
+	}
+	return defaultEndpoint()
+}
+
+func (l *bluemixEndpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *bluemixEndpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *bluemixEndpointLocator) ContainerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *bluemixEndpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *bluemixEndpointLocator) CisEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *bluemixEndpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *bluemixEndpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *bluemixEndpointLocator) IAMEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *bluemixEndpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *bluemixEndpointLocator) ICDEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *bluemixEndpointLocator) MCCPAPIEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *bluemixEndpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *bluemixEndpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *bluemixEndpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *bluemixEndpointLocator) UAAEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *bluemixEndpointLocator) CseEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CSE_ENDPOINT", l.EndpointLocator.CseEndpoint)
+}
+
+func (l *bluemixEndpointLocator) SchematicsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *bluemixEndpointLocator) UserManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *bluemixEndpointLocator) HpcsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *bluemixEndpointLocator) FunctionsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
+
+func (l *bluemixEndpointLocator) SatelliteEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SAT_API_ENDPOINT", l.EndpointLocator.SatelliteEndpoint)
+}
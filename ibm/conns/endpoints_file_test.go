@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeEndpointsFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEndpointsFileJSON(t *testing.T) {
+	path := writeEndpointsFile(t, "endpoints.json", `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": "https://us-south.private.iaas.cloud.ibm.com/v1"}}
+	}`)
+
+	fileMap, warnings, err := LoadEndpointsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %v", warnings)
+	}
+	url := fileFallBack(fileMap, "private", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "default")
+	if url != "https://us-south.private.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("bad endpoint: %s", url)
+	}
+}
+
+func TestLoadEndpointsFileYAML(t *testing.T) {
+	path := writeEndpointsFile(t, "endpoints.yaml", `
+IBMCLOUD_IAM_API_ENDPOINT:
+  private:
+    us-south: https://private.iam.cloud.ibm.com
+IBMCLOUD_IS_NG_API_ENPOINT:
+  public:
+    us-south: https://us-south.iaas.cloud.ibm.com/v1
+IBMCLOUD_IS_NG_API_ENDPOINT:
+  privat:
+    us-south: https://us-south.private.iaas.cloud.ibm.com/v1
+`)
+
+	fileMap, warnings, err := LoadEndpointsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url := fileFallBack(fileMap, "private", "IBMCLOUD_IAM_API_ENDPOINT", "us-south", ""); url != "https://private.iam.cloud.ibm.com" {
+		t.Fatalf("bad endpoint: %s", url)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", warnings)
+	}
+	if !strings.Contains(warnings[0], "Unknown visibility privat") {
+		t.Fatalf("expected an unknown visibility warning, got %q", warnings[0])
+	}
+	if !strings.Contains(warnings[1], "Did you mean IBMCLOUD_IS_NG_API_ENDPOINT?") {
+		t.Fatalf("expected a typo suggestion, got %q", warnings[1])
+	}
+}
+
+func TestLoadEndpointsFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"missing.json":   "",
+		"invalid.json":   `{"IBMCLOUD_IAM_API_ENDPOINT": `,
+		"shape.json":     `{"IBMCLOUD_IAM_API_ENDPOINT": "https://private.iam.cloud.ibm.com"}`,
+		"region.yaml":    "IBMCLOUD_IAM_API_ENDPOINT:\n  private: https://private.iam.cloud.ibm.com\n",
+		"url.yaml":       "IBMCLOUD_IAM_API_ENDPOINT:\n  private:\n    us-south: 42\n",
+		"not-a-map.yaml": "- IBMCLOUD_IAM_API_ENDPOINT\n",
+	}
+	for name, content := range cases {
+		path := filepath.Join(t.TempDir(), name)
+		if content != "" {
+			path = writeEndpointsFile(t, name, content)
+		}
+		if _, _, err := LoadEndpointsFile(path); err == nil {
+			t.Fatalf("expected an error for %s", name)
+		}
+	}
+
+	if fileMap, _, err := LoadEndpointsFile(""); fileMap != nil || err != nil {
+		t.Fatalf("expected no endpoints file, got %v, %v", fileMap, err)
+	}
+}
+
+func TestFileFallBackInvalidFile(t *testing.T) {
+	path := writeEndpointsFile(t, "invalid.json", "{")
+	if url := FileFallBack(path, "private", "IBMCLOUD_IAM_API_ENDPOINT", "us-south", "default"); url != "default" {
+		t.Fatalf("expected the default endpoint, got %s", url)
+	}
+}
+
+func TestBluemixEndpointLocator(t *testing.T) {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", "")
+	path := writeEndpointsFile(t, "endpoints.yaml", `
+IBMCLOUD_CS_API_ENDPOINT:
+  private:
+    us-south: https://private.us-south.containers.test.cloud.ibm.com
+IBMCLOUD_GT_API_ENDPOINT:
+  private:
+    us-south: https://tags.private.test.cloud.ibm.com
+`)
+	fileMap, _, err := LoadEndpointsFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if newBluemixEndpointLocator(nil, "us-south", "private") != nil {
+		t.Fatal("expected the bluemix-go locator without endpoints file")
+	}
+	locator := newBluemixEndpointLocator(fileMap, "us-south", "private")
+	if url, err := locator.ContainerEndpoint(); err != nil || url != "https://private.us-south.containers.test.cloud.ibm.com" {
+		t.Fatalf("expected the endpoint of the file, got %s, %v", url, err)
+	}
+	if url, err := locator.IAMEndpoint(); err != nil || url != "https://private.us-south.iam.cloud.ibm.com" {
+		t.Fatalf("expected the default endpoint, got %s, %v", url, err)
+	}
+	t.Setenv("IBMCLOUD_GT_API_ENDPOINT", "https://tags.test.cloud.ibm.com")
+	if url, err := locator.GlobalTaggingEndpoint(); err != nil || url != "https://tags.test.cloud.ibm.com" {
+		t.Fatalf("expected the endpoint of the environment, got %s, %v", url, err)
+	}
+}
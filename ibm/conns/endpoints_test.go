@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestEndpointFallBackPrecedence(t *testing.T) {
+	fileMap := map[string]interface{}{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": map[string]interface{}{
+			"private": map[string]interface{}{"us-south": "https://file.example.com/v1"},
+		},
+	}
+	fromFile := fileFallBack(fileMap, "private", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "https://us-south.iaas.cloud.ibm.com/v1")
+
+	sess := &Session{}
+	if url := sess.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, fromFile); url != "https://file.example.com/v1" {
+		t.Fatalf("expected the endpoints file value, got %s", url)
+	}
+
+	t.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://env.example.com/v1")
+	if url := sess.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, fromFile); url != "https://env.example.com/v1" {
+		t.Fatalf("expected the environment variable value, got %s", url)
+	}
+
+	sess.Endpoints = map[string]string{"IBMCLOUD_IS_NG_API_ENDPOINT": "http://localhost:8080/v1"}
+	if url := sess.endpointFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, fromFile); url != "http://localhost:8080/v1" {
+		t.Fatalf("expected the endpoints block value, got %s", url)
+	}
+}
+
+func TestServiceEndpointKeysAreUnique(t *testing.T) {
+	seen := map[string]string{}
+	for name, key := range ServiceEndpointKeys {
+		if other, ok := seen[key]; ok {
+			t.Fatalf("%s and %s both override %s", name, other, key)
+		}
+		seen[key] = name
+	}
+}
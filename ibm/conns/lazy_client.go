@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	"sync"
+)
+
+// lazyClient defers the construction of a service client until the first
+// time it is requested. The build function runs at most once; its outcome
+// (client and configuration error) is cached on the client session.
+type lazyClient struct {
+	name  string
+	once  sync.Once
+	build func()
+}
+
+func (l *lazyClient) init() {
+	l.once.Do(func() {
+		log.Printf("[DEBUG] Configuring %s client", l.name)
+		l.build()
+	})
+}
+
+// lazyClients is the set of deferred client builders of a client session,
+// keyed by service name. It is populated while the session is configured
+// and only read afterwards, so it is safe for concurrent use.
+type lazyClients map[string]*lazyClient
+
+// register adds the builder for the named service.
+func (l lazyClients) register(name string, build func()) {
+	l[name] = &lazyClient{name: name, build: build}
+}
+
+// init builds the named service client if it has not been built yet. Unknown
+// services are ignored, which leaves the zero value (or a configuration error
+// set eagerly, e.g. for missing credentials) in place.
+func (l lazyClients) init(name string) {
+	if c, ok := l[name]; ok {
+		c.init()
+	}
+}
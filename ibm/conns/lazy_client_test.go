@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestLazyClientsBuildOnce(t *testing.T) {
+	clients := lazyClients{}
+	calls := 0
+	clients.register("vpc", func() {
+		calls++
+	})
+
+	if calls != 0 {
+		t.Fatalf("client was built on register, calls = %d", calls)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clients.init("vpc")
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected the client to be built once, got %d", calls)
+	}
+}
+
+func TestLazyClientsIsolatesErrors(t *testing.T) {
+	session := &clientSession{clients: lazyClients{}}
+	session.clients.register("logs", func() {
+		session.logsClientErr = errors.New("unreachable endpoint")
+	})
+	session.clients.register("project", func() {
+		t.Fatal("project client must not be built")
+	})
+
+	if _, err := session.LogsV0(); err == nil {
+		t.Fatal("expected the logs configuration error")
+	}
+}
+
+func TestLazyClientsUnknownService(t *testing.T) {
+	session := &clientSession{clients: lazyClients{}}
+	session.logsClientErr = errEmptyBluemixCredentials
+
+	if _, err := session.LogsV0(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected the eagerly set error, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrProtected is returned for the deletions and the replacements rejected by
+// the deletion protection of the provider, see ProtectionConfig.
+var ErrProtected = errors.New("the resource is protected by the provider configuration")
+
+// ProtectionConfig holds the provider level deletion protection settings. The
+// resources of the ResourceTypes, and the resources with the Tag in their user
+// tags, can be neither deleted nor replaced unless Override is set.
+type ProtectionConfig struct {
+	ResourceTypes []string
+	Tag           string
+	Override      bool
+}
+
+// Protects reports whether the resource of type resourceType with the user
+// tags tags is protected, and why. A resource type ending with * matches the
+// resource types starting with its prefix. The tags are compared regardless of
+// case and of the spaces around them, as the Global Tagging API does.
+func (p *ProtectionConfig) Protects(resourceType string, tags []string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	for _, protected := range p.ResourceTypes {
+		if prefix, ok := strings.CutSuffix(protected, "*"); ok && strings.HasPrefix(resourceType, prefix) || resourceType == protected {
+			return fmt.Sprintf("the resource type %s is protected by protect_resource_types", resourceType), true
+		}
+	}
+	if tag := strings.TrimSpace(p.Tag); tag != "" {
+		for _, t := range tags {
+			if strings.EqualFold(strings.TrimSpace(t), tag) {
+				return fmt.Sprintf("the resource is tagged %s, protected by protect_tagged", tag), true
+			}
+		}
+	}
+	return "", false
+}
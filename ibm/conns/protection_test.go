@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestProtectionConfigProtects(t *testing.T) {
+	c := &ProtectionConfig{
+		ResourceTypes: []string{"ibm_database", "ibm_kms_*"},
+		Tag:           "protect:true",
+	}
+	cases := []struct {
+		resourceType string
+		tags         []string
+		protected    bool
+	}{
+		{"ibm_database", nil, true},
+		{"ibm_database_backup", nil, false},
+		{"ibm_kms_key", nil, true},
+		{"ibm_cos_bucket", []string{"env:dev"}, false},
+		{"ibm_cos_bucket", []string{"env:dev", "Protect:True "}, true},
+		{"ibm_cos_bucket", []string{"protect:false"}, false},
+	}
+	for _, tc := range cases {
+		reason, protected := c.Protects(tc.resourceType, tc.tags)
+		if protected != tc.protected {
+			t.Fatalf("%s %v: expected protected %t, got %t", tc.resourceType, tc.tags, tc.protected, protected)
+		}
+		if protected && reason == "" {
+			t.Fatalf("%s %v: expected the reason of the protection", tc.resourceType, tc.tags)
+		}
+	}
+
+	var nilConfig *ProtectionConfig
+	if _, protected := nilConfig.Protects("ibm_database", nil); protected {
+		t.Fatal("expected no protection without configuration")
+	}
+}
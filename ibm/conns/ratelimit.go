@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	"math"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig throttles the requests of the clients of a service. A zero
+// RequestsPerSecond or MaxInFlight disables the corresponding limit.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the rate at which the token bucket refills
+	RequestsPerSecond float64
+	// Burst is the size of the token bucket, by default RequestsPerSecond rounded up
+	Burst int
+	// MaxInFlight caps the requests waiting for a response
+	MaxInFlight int
+}
+
+// merge returns r with the non-zero settings of override applied.
+func (r RateLimitConfig) merge(override RateLimitConfig) RateLimitConfig {
+	if override.RequestsPerSecond > 0 {
+		r.RequestsPerSecond = override.RequestsPerSecond
+	}
+	if override.Burst > 0 {
+		r.Burst = override.Burst
+	}
+	if override.MaxInFlight > 0 {
+		r.MaxInFlight = override.MaxInFlight
+	}
+	return r
+}
+
+// serviceLimiter throttles the requests of the clients of one service, which
+// share it, with a token bucket and a cap on the requests in flight.
+type serviceLimiter struct {
+	name     string
+	rate     *rate.Limiter
+	inFlight chan struct{}
+}
+
+// newServiceLimiter returns the limiter of the named service, or nil when
+// config sets no limit.
+func newServiceLimiter(name string, config RateLimitConfig) *serviceLimiter {
+	if config.RequestsPerSecond <= 0 && config.MaxInFlight <= 0 {
+		return nil
+	}
+	l := &serviceLimiter{name: name}
+	if config.RequestsPerSecond > 0 {
+		burst := config.Burst
+		if burst < 1 {
+			burst = int(math.Ceil(config.RequestsPerSecond))
+		}
+		l.rate = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), burst)
+	}
+	if config.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, config.MaxInFlight)
+	}
+	return l
+}
+
+// acquire blocks until req may be sent. The returned function releases the
+// in-flight slot of the request and must be called once its response arrives.
+func (l *serviceLimiter) acquire(req *http.Request) (func(), error) {
+	ctx := req.Context()
+	release := func() {}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		default:
+			log.Printf("[DEBUG] %d requests to %s in flight, queueing %s %s", cap(l.inFlight), l.name, req.Method, req.URL.Redacted())
+			select {
+			case l.inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		release = func() { <-l.inFlight }
+	}
+	if l.rate != nil {
+		reservation := l.rate.Reserve()
+		if wait := reservation.Delay(); wait > 0 {
+			log.Printf("[DEBUG] Rate limit of %s reached, queueing %s %s for %s", l.name, req.Method, req.URL.Redacted(), wait)
+			if err := sleepContext(ctx, wait); err != nil {
+				reservation.Cancel()
+				release()
+				return nil, err
+			}
+		}
+	}
+	return release, nil
+}
+
+// limitedTransport sends the requests of a service client through the
+// limiter of the service.
+type limitedTransport struct {
+	base    http.RoundTripper
+	limiter *serviceLimiter
+}
+
+// RoundTrip holds the in-flight slot of the request until its response
+// arrives, not until its body is closed, so that a body left unclosed does not
+// keep the slot.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return t.base.RoundTrip(req)
+}
+
+// serviceTransport returns the transport of the clients of the named
+// service: the shared transport behind the limiter of the service, if any,
+// traced as the requests of the service when the tracing is enabled.
+func (s *Session) serviceTransport(name string) http.RoundTripper {
+	transport := http.RoundTripper(&sharedTransport{base: s.baseTransport()})
+	if limiter := s.limiter(name); limiter != nil {
+		transport = &limitedTransport{base: transport, limiter: limiter}
+	}
+	return tracedTransport(transport, name)
+}
+
+// limiter returns the limiter of the named service, creating it on first use
+// so that all the clients of the service share it.
+func (s *Session) limiter(name string) *serviceLimiter {
+	s.limitersMu.Lock()
+	defer s.limitersMu.Unlock()
+	if l, ok := s.limiters[name]; ok {
+		return l
+	}
+	if s.limiters == nil {
+		s.limiters = map[string]*serviceLimiter{}
+	}
+	l := newServiceLimiter(name, s.RateLimit.merge(s.ServiceRateLimits[name]))
+	s.limiters[name] = l
+	return l
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServiceLimiterDisabled(t *testing.T) {
+	if l := newServiceLimiter("iam", RateLimitConfig{}); l != nil {
+		t.Fatalf("expected no limiter, got %+v", l)
+	}
+	t.Setenv(TracingEnvVar, "")
+	sess := &Session{}
+	if _, ok := sess.serviceTransport("iam").(*sharedTransport); !ok {
+		t.Fatalf("expected the shared transport without a rate limit")
+	}
+}
+
+func TestServiceLimiterSharedPerService(t *testing.T) {
+	sess := &Session{
+		RateLimit:         RateLimitConfig{RequestsPerSecond: 10},
+		ServiceRateLimits: map[string]RateLimitConfig{"iam": {MaxInFlight: 2}},
+	}
+	iam := sess.limiter("iam")
+	if iam != sess.limiter("iam") || iam == sess.limiter("vpc") {
+		t.Fatalf("expected one limiter per service")
+	}
+	if cap(iam.inFlight) != 2 || iam.rate == nil || iam.rate.Burst() != 10 {
+		t.Fatalf("expected the merged settings, got %+v", iam)
+	}
+}
+
+func TestLimitedTransportMaxInFlight(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer server.Close()
+
+	sess := &Session{Transport: http.DefaultTransport.(*http.Transport), RateLimit: RateLimitConfig{MaxInFlight: 2}}
+	client := &http.Client{Transport: sess.serviceTransport("vpc")}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	if peak > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func TestLimitedTransportUnclosedBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"r006-1"}`))
+	}))
+	defer server.Close()
+
+	sess := &Session{Transport: http.DefaultTransport.(*http.Transport), RateLimit: RateLimitConfig{MaxInFlight: 1}}
+	client := &http.Client{Transport: sess.serviceTransport("vpc")}
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+		// The body of the response is neither read nor closed
+		_, err := client.Do(req)
+		cancel()
+		if err != nil {
+			t.Fatalf("request %d: expected the slot of the unclosed body to be released, got %s", i, err)
+		}
+	}
+}
+
+func TestLimitedTransportRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	sess := &Session{Transport: http.DefaultTransport.(*http.Transport), RateLimit: RateLimitConfig{RequestsPerSecond: 20, Burst: 1}}
+	client := &http.Client{Transport: sess.serviceTransport("global_tagging")}
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected 5 requests at 20 per second to take at least 200ms, took %s", elapsed)
+	}
+}
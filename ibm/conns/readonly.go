@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrReadOnly is returned for the requests and the operations rejected by a
+// provider configured with read_only = true.
+var ErrReadOnly = errors.New("the provider is configured with read_only = true")
+
+// readOnlyPaths are the paths, by suffix, of the POST requests that read
+// without changing anything and are allowed in read-only mode.
+var readOnlyPaths = []string{
+	// IAM token exchange, needed to authenticate any request
+	"/identity/token",
+	// Global Search
+	"/v3/resources/search",
+}
+
+// readOnlyTransport rejects the requests that may change a resource, which
+// are all the requests but GET, HEAD and OPTIONS ones and the POST requests
+// of readOnlyPaths.
+type readOnlyTransport struct {
+	base http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !readOnlyAllowed(req) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("%w: %s %s is not allowed", ErrReadOnly, req.Method, req.URL.Redacted())
+	}
+	return t.base.RoundTrip(req)
+}
+
+func readOnlyAllowed(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		for _, path := range readOnlyPaths {
+			if strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), path) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	sess := &Session{Transport: &http.Transport{}, ReadOnly: true}
+	client := &http.Client{Transport: sess.serviceTransport("vpc")}
+
+	for _, allowed := range []struct{ method, path string }{
+		{http.MethodGet, "/v1/vpcs"},
+		{http.MethodHead, "/v1/vpcs"},
+		{http.MethodPost, "/identity/token"},
+		{http.MethodPost, "/v3/resources/search"},
+	} {
+		req, _ := http.NewRequest(allowed.method, server.URL+allowed.path, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s should be allowed: %s", allowed.method, allowed.path, err)
+		}
+		resp.Body.Close()
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		req, _ := http.NewRequest(method, server.URL+"/v1/vpcs", strings.NewReader("{}"))
+		_, err := client.Do(req)
+		if !errors.Is(err, ErrReadOnly) {
+			t.Fatalf("%s should be rejected with ErrReadOnly, got %v", method, err)
+		}
+	}
+	if requests != 4 {
+		t.Fatalf("expected 4 requests to reach the server, got %d", requests)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/trace"
+)
+
+// redactedValue replaces the values of the sensitive fields.
+const redactedValue = "REDACTED"
+
+// sensitiveFieldParts are the parts of the names, lowercased, of the JSON,
+// form, query and YAML fields whose values are redacted. The whole value of
+// a matching field is redacted, including any nested object.
+var sensitiveFieldParts = []string{
+	"password", "passwd", "passphrase", "passcode", "secret", "token",
+	"apikey", "api_key", "private_key", "credentials", "payload",
+}
+
+// nonSensitiveFieldSuffixes mark the fields that refer to a secret rather
+// than hold one, such as secret_id or token_type.
+var nonSensitiveFieldSuffixes = []string{"_id", "_ids", "_type", "_crn", "_name"}
+
+// genericFieldNames are too common to be redacted wherever they appear, even
+// though some schemas mark a field of that name Sensitive.
+var genericFieldNames = map[string]bool{
+	"id": true, "name": true, "key": true, "value": true, "data": true,
+	"client_id": true, "instance_name": true,
+}
+
+// sensitiveFields are the names of the schema fields marked Sensitive, see
+// RegisterSensitiveFields.
+var (
+	sensitiveFieldsMu sync.RWMutex
+	sensitiveFields   = map[string]bool{}
+)
+
+// RegisterSensitiveFields adds the names of schema fields marked Sensitive to
+// the fields redacted from the logs and the audit log.
+func RegisterSensitiveFields(names ...string) {
+	sensitiveFieldsMu.Lock()
+	defer sensitiveFieldsMu.Unlock()
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if !genericFieldNames[name] {
+			sensitiveFields[name] = true
+		}
+	}
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	sensitiveFieldsMu.RLock()
+	registered := sensitiveFields[name]
+	sensitiveFieldsMu.RUnlock()
+	if registered {
+		return true
+	}
+	for _, suffix := range nonSensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for key := range query {
+		if isSensitiveField(key) {
+			query.Set(key, redactedValue)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.Redacted()
+}
+
+// redactBody returns a JSON or form body with the values of its sensitive
+// fields redacted, as JSON. Bodies in any other format are left out.
+func redactBody(contentType string, body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil
+		}
+		for key := range form {
+			if isSensitiveField(key) {
+				form.Set(key, redactedValue)
+			}
+		}
+		redacted, _ := json.Marshal(form.Encode())
+		return redacted
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redactJSON(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSensitiveField(key) && field != nil {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSON(field)
+		}
+	case []interface{}:
+		for n, item := range v {
+			v[n] = redactJSON(item)
+		}
+	}
+	return value
+}
+
+var (
+	// secretHeaderPattern matches the header lines of the request and response dumps that carry credentials
+	secretHeaderPattern = regexp.MustCompile(`(?im)^((?:authorization|proxy-authorization|x-auth-token|x-auth-refresh-token|x-auth-uaa-token|x-auth-user-token|refresh-token|x-api-key|cookie|set-cookie)[ \t]*:[ \t]*)\S[^\r\n]*`)
+	// authSchemePattern matches the credentials of an Authorization header value
+	authSchemePattern = regexp.MustCompile(`(?i)\b(bearer|basic)[ \t]+[A-Za-z0-9\-._~+/]+=*`)
+	// jwtPattern matches JSON web tokens, such as the IAM access tokens
+	jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]{4,}\.eyJ[A-Za-z0-9_-]{4,}\.[A-Za-z0-9_-]*`)
+	// userinfoPattern matches the passwords of URLs, such as database connection strings
+	userinfoPattern = regexp.MustCompile(`([A-Za-z][A-Za-z0-9+.-]*://[^/\s:@"'\\]+):[^/\s@"'\\]+@`)
+	// jsonFieldPattern matches a JSON field with a string or a flat object value
+	jsonFieldPattern = regexp.MustCompile(`(")([^"\\]+)"(\s*:\s*)("(?:[^"\\]|\\.)*"|\{[^{}]*\})`)
+	// escapedJSONFieldPattern matches a JSON field with a string value in a JSON string, such as an error message
+	escapedJSONFieldPattern = regexp.MustCompile(`(\\")([^"\\]+)\\"(\s*:\s*)\\"[^"\\]*\\"`)
+	// formFieldPattern matches a field of a form body or of a query string
+	formFieldPattern = regexp.MustCompile(`(^|[?&\s])([A-Za-z0-9_.-]+)=([^&\s"']+)`)
+	// yamlFieldPattern matches a field of the YAML messages of the TerraformProblems
+	yamlFieldPattern = regexp.MustCompile(`(?m)^([ \t]*(?:- )?)([A-Za-z0-9_.-]+):[ \t]+(\S[^\r\n]*)`)
+)
+
+// RedactSecrets masks the credentials in a log message: the Authorization
+// and token headers, the JSON web tokens, the passwords of URLs and the
+// values of the sensitive JSON, form, query and YAML fields, see
+// isSensitiveField.
+func RedactSecrets(message string) string {
+	message = secretHeaderPattern.ReplaceAllString(message, "${1}"+redactedValue)
+	message = authSchemePattern.ReplaceAllString(message, "${1} "+redactedValue)
+	message = jwtPattern.ReplaceAllString(message, redactedValue)
+	message = userinfoPattern.ReplaceAllString(message, "${1}:"+redactedValue+"@")
+	for _, pattern := range []*regexp.Regexp{jsonFieldPattern, escapedJSONFieldPattern} {
+		message = replaceSensitive(pattern, message, func(m []string) string {
+			quote := m[1]
+			return quote + m[2] + quote + m[3] + quote + redactedValue + quote
+		})
+	}
+	message = replaceSensitive(formFieldPattern, message, func(m []string) string {
+		return m[1] + m[2] + "=" + redactedValue
+	})
+	message = replaceSensitive(yamlFieldPattern, message, func(m []string) string {
+		return m[1] + m[2] + ": " + redactedValue
+	})
+	return message
+}
+
+// replaceSensitive replaces the matches of pattern whose second group names a
+// sensitive field with the result of redact.
+func replaceSensitive(pattern *regexp.Regexp, message string, redact func(match []string) string) string {
+	return pattern.ReplaceAllStringFunc(message, func(match string) string {
+		m := pattern.FindStringSubmatch(match)
+		if m == nil || !isSensitiveField(m[2]) {
+			return match
+		}
+		return redact(m)
+	})
+}
+
+// redactingWriter masks the credentials in the log messages written to out.
+type redactingWriter struct {
+	out io.Writer
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.out, RedactSecrets(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// RedactLogs masks the credentials in the messages of the standard logger,
+// which carries the provider logs and the request dumps of the IBM Cloud
+// SDKs, and in the bluemix-go trace.
+func RedactLogs() {
+	if _, ok := log.Writer().(*redactingWriter); !ok {
+		log.SetOutput(&redactingWriter{out: log.Writer()})
+	}
+	switch logger := trace.Logger.(type) {
+	case *trace.NullLogger:
+	case *log.Logger:
+		if _, ok := logger.Writer().(*redactingWriter); !ok {
+			trace.Logger = log.New(&redactingWriter{out: logger.Writer()}, "", 0)
+		}
+	default:
+		trace.Logger = log.New(&redactingWriter{out: os.Stderr}, "", 0)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	"sync"
+)
+
+// regionalSession is the client session of another region of a provider
+// configuration, created on first use.
+type regionalSession struct {
+	once    sync.Once
+	session *clientSession
+	err     error
+}
+
+// Region returns the region of the session.
+func (sess *clientSession) Region() string {
+	return sess.config.Region
+}
+
+// ForRegion returns the client session of region, configured as the session
+// of the provider configuration but for region. The sessions of the other
+// regions are created on first use and cached, so that the resources of a
+// region share its service clients. An empty region returns the session
+// itself.
+func (sess *clientSession) ForRegion(region string) (ClientSession, error) {
+	if region == "" || region == sess.config.Region {
+		return sess, nil
+	}
+	root := sess
+	if sess.parent != nil {
+		root = sess.parent
+	}
+	if region == root.config.Region {
+		return root, nil
+	}
+
+	root.regionsMu.Lock()
+	r, ok := root.regions[region]
+	if !ok {
+		if root.regions == nil {
+			root.regions = map[string]*regionalSession{}
+		}
+		r = &regionalSession{}
+		root.regions[region] = r
+	}
+	root.regionsMu.Unlock()
+
+	r.once.Do(func() {
+		log.Printf("[INFO] Configuring the client session of region %s", region)
+		c := root.config
+		c.Region = region
+		r.session, r.err = c.clientSession(root)
+	})
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.session, nil
+}
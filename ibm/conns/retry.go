@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryBaseDelay is the wait before the first retry when the provider
+// retry block does not set one.
+const DefaultRetryBaseDelay = 1 * time.Second
+
+// defaultRetryStatusCodes are retried by every client, in addition to the
+// status codes of RetryConfig.
+var defaultRetryStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+// RetryConfig holds the retry settings of the service clients. Delays grow
+// exponentially from BaseDelay up to MaxDelay, unless a 429 or 503 response
+// carries a Retry-After header, which is honored instead.
+type RetryConfig struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	Jitter     bool
+	// StatusCodes are retried in addition to defaultRetryStatusCodes
+	StatusCodes []int
+}
+
+// merge returns r with the non-zero settings of override applied.
+func (r RetryConfig) merge(override RetryConfig) RetryConfig {
+	if override.MaxRetries > 0 {
+		r.MaxRetries = override.MaxRetries
+	}
+	if override.BaseDelay > 0 {
+		r.BaseDelay = override.BaseDelay
+	}
+	if override.MaxDelay > 0 {
+		r.MaxDelay = override.MaxDelay
+	}
+	return r
+}
+
+// isRetryableStatus reports whether a response with the given status code is retried.
+func (r RetryConfig) isRetryableStatus(code int) bool {
+	for _, c := range defaultRetryStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	for _, c := range r.StatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the wait before retry attempt (starting at 0) of a request
+// that got resp.
+func (r RetryConfig) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return wait
+	}
+
+	base := r.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+	wait := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if r.MaxDelay > 0 && (wait > r.MaxDelay || wait <= 0) {
+		wait = r.MaxDelay
+	}
+	if r.Jitter && wait > 1 {
+		// Equal jitter: keep half of the delay and randomize the other half
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	}
+	return wait
+}
+
+// retryAfter returns the wait requested by the Retry-After header of a 429
+// or 503 response, given either in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// retryTransport retries the requests of the bluemix-go clients that end with
+// a retryable status code. bluemix-go only retries timeouts by itself.
+type retryTransport struct {
+	base  http.RoundTripper
+	retry RetryConfig
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || attempt >= t.retry.MaxRetries || !t.retry.isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+		// A body that cannot be read again rules out a retry
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+
+		wait := t.retry.backoff(attempt, resp)
+		log.Printf("[DEBUG] %s %s returned %d, retrying in %s (%d/%d)", req.Method, req.URL.Redacted(), resp.StatusCode, wait, attempt+1, t.retry.MaxRetries)
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		resp.Body.Close()
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		next := req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			next.Body = body
+		}
+		req = next
+	}
+}
+
+func sleepContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	retry := RetryConfig{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if wait := retry.backoff(attempt, nil); wait != expected {
+			t.Fatalf("attempt %d: expected %s, got %s", attempt, expected, wait)
+		}
+	}
+
+	retry.Jitter = true
+	for attempt := 0; attempt < 10; attempt++ {
+		if wait := retry.backoff(2, nil); wait < 2*time.Second || wait > 4*time.Second {
+			t.Fatalf("jittered delay out of range: %s", wait)
+		}
+	}
+}
+
+func TestRetryBackoffRetryAfter(t *testing.T) {
+	retry := RetryConfig{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := retry.backoff(0, resp); wait != 7*time.Second {
+		t.Fatalf("expected the Retry-After delay, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if wait := retry.backoff(0, resp); wait < 58*time.Second || wait > time.Minute {
+		t.Fatalf("expected the Retry-After date to be honored, got %s", wait)
+	}
+
+	resp.StatusCode = http.StatusInternalServerError
+	if wait := retry.backoff(0, resp); wait != time.Second {
+		t.Fatalf("expected Retry-After to be ignored on a 500, got %s", wait)
+	}
+}
+
+func TestRetryConfigMerge(t *testing.T) {
+	retry := RetryConfig{MaxRetries: 10, BaseDelay: time.Second, MaxDelay: 5 * time.Second, Jitter: true, StatusCodes: []int{409}}
+	merged := retry.merge(RetryConfig{MaxRetries: 3, MaxDelay: time.Minute})
+	if merged.MaxRetries != 3 || merged.BaseDelay != time.Second || merged.MaxDelay != time.Minute || !merged.Jitter {
+		t.Fatalf("bad merge: %+v", merged)
+	}
+	if !merged.isRetryableStatus(409) || !merged.isRetryableStatus(429) || merged.isRetryableStatus(404) {
+		t.Fatalf("bad retryable status codes: %+v", merged)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make([]byte, r.ContentLength)
+		r.Body.Read(body)
+		if string(body) != "payload" {
+			t.Errorf("expected the request body on every attempt, got %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base:  http.DefaultTransport,
+		retry: RetryConfig{MaxRetries: 5, BaseDelay: time.Hour},
+	}}
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("expected success after 3 calls, got %d after %d", resp.StatusCode, calls)
+	}
+
+	atomic.StoreInt32(&calls, -10)
+	client.Transport.(*retryTransport).retry.MaxRetries = 1
+	resp, err = client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || calls != -8 {
+		t.Fatalf("expected the last 429 after 2 calls, got %d after %d", resp.StatusCode, calls+10)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// BluemixServiceName stands for the bluemix-go based clients, such as the
+// Kubernetes Service, Cloud Foundry and resource controller v1 clients, which
+// share their settings.
+const BluemixServiceName = "bluemix"
+
+// ServiceNames are the services whose client settings, retries and rate
+// limits, can be overridden per service in the provider block.
+var ServiceNames = []string{
+	"app_configuration", "appid", "atracker", "backup_recovery", BluemixServiceName, "catalog_management",
+	"cis", "cloud_shell", "code_engine", "configuration_aggregator", "container_registry",
+	"context_based_restrictions", "databases", "db2", "directlink", "directlink_provider",
+	"enterprise", "event_notifications", "event_streams", "global_catalog", "global_search",
+	"global_tagging", "iam", "logs", "logs_routing", "metrics_routing", "mqcloud",
+	"partner_center_sell", "private_dns", "project", "push_notifications",
+	"resource_controller", "resource_manager", "satellite", "satellite_link", "scc",
+	"schematics", "sdsaas", "secrets_manager", "tekton_pipeline", "toolchain",
+	"transit_gateway", "uko", "usage_reports", "vmware", "vpc",
+}
+
+// configureService points a go-sdk-core service client at the shared
+// transport of the session and enables retries with the settings of the
+// named service (see ServiceNames).
+func (s *Session) configureService(service *core.BaseService, name string) {
+	retry := s.Retry.merge(s.ServiceRetries[name])
+
+	service.SetHTTPClient(&http.Client{Transport: s.serviceTransport(name), Timeout: s.HTTPTimeout})
+	service.EnableRetries(retry.MaxRetries, retry.MaxDelay)
+	tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok {
+		return
+	}
+	if retry.BaseDelay > 0 {
+		tr.Client.RetryWaitMin = retry.BaseDelay
+	}
+	tr.Client.Backoff = func(_, _ time.Duration, attempt int, resp *http.Response) time.Duration {
+		return retry.backoff(attempt, resp)
+	}
+	tr.Client.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if err == nil && ctx.Err() == nil && resp != nil && retry.isRetryableStatus(resp.StatusCode) {
+			return true, nil
+		}
+		return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	}
+}
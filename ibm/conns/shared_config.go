@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SharedConfigProfile is a named profile of the shared config file. Its
+// fields are named after the provider arguments they stand in for.
+type SharedConfigProfile struct {
+	IBMCloudAPIKey        string `json:"ibmcloud_api_key" yaml:"ibmcloud_api_key"`
+	IAMTrustedProfileID   string `json:"iam_profile_id" yaml:"iam_profile_id"`
+	IAMTrustedProfileName string `json:"iam_profile_name" yaml:"iam_profile_name"`
+	ComputeResourceAuth   string `json:"compute_resource_auth" yaml:"compute_resource_auth"`
+	CRTokenFilename       string `json:"cr_token_filename" yaml:"cr_token_filename"`
+	IAASClassicUsername   string `json:"iaas_classic_username" yaml:"iaas_classic_username"`
+	IAASClassicAPIKey     string `json:"iaas_classic_api_key" yaml:"iaas_classic_api_key"`
+	Region                string `json:"region" yaml:"region"`
+	ResourceGroup         string `json:"resource_group" yaml:"resource_group"`
+	Visibility            string `json:"visibility" yaml:"visibility"`
+}
+
+// HasCredentials reports whether the profile sets the IBM Cloud platform
+// credentials, an API key or a compute resource identity.
+func (p *SharedConfigProfile) HasCredentials() bool {
+	return p.IBMCloudAPIKey != "" || p.ComputeResourceAuth != ""
+}
+
+type sharedConfigFile struct {
+	Profiles map[string]*SharedConfigProfile `json:"profiles" yaml:"profiles"`
+}
+
+// DefaultSharedConfigFile returns the path of the shared config file used
+// when none is set, ~/.ibmcloud/terraform/config.json.
+func DefaultSharedConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".ibmcloud", "terraform", "config.json")
+}
+
+// LoadSharedConfigProfile reads the profile name of the shared config file at
+// path. The file is YAML when its extension is .yaml or .yml, JSON otherwise,
+// and maps profile names to profiles under a top level profiles key. Unknown
+// keys are reported as errors, so that a misspelled credential is not
+// silently ignored.
+func LoadSharedConfigProfile(path, name string) (*SharedConfigProfile, error) {
+	if path == "" {
+		path = DefaultSharedConfigFile()
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read shared config file %s: %s", path, err)
+	}
+	var file sharedConfigFile
+	if isYAMLFile(path) {
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&file)
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to parse shared config file %s: %s", path, err)
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok || profile == nil {
+		names := make([]string, 0, len(file.Profiles))
+		for n := range file.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("[ERROR] Profile %s not found in shared config file %s, found profiles: %s", name, path, strings.Join(names, ", "))
+	}
+	if err := validateSharedConfigProfile(profile); err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid profile %s in shared config file %s: %s", name, path, err)
+	}
+	return profile, nil
+}
+
+func validateSharedConfigProfile(p *SharedConfigProfile) error {
+	if p.Visibility != "" && !contains([]string{"public", "private", "public-and-private"}, p.Visibility) {
+		return fmt.Errorf("visibility must be one of public, private, public-and-private, got %q", p.Visibility)
+	}
+	if p.ComputeResourceAuth != "" && !contains(ComputeResourceAuthTypes, p.ComputeResourceAuth) {
+		return fmt.Errorf("compute_resource_auth must be one of %s, got %q", strings.Join(ComputeResourceAuthTypes, ", "), p.ComputeResourceAuth)
+	}
+	if p.IBMCloudAPIKey != "" && p.ComputeResourceAuth != "" {
+		return fmt.Errorf("ibmcloud_api_key and compute_resource_auth cannot be used together")
+	}
+	return nil
+}
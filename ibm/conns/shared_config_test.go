@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadSharedConfigProfileJSON(t *testing.T) {
+	path := writeEndpointsFile(t, "config.json", `{
+		"profiles": {
+			"dev": {"ibmcloud_api_key": "dev-key", "region": "eu-de", "resource_group": "rg-dev"},
+			"runner": {"compute_resource_auth": "container", "iam_profile_name": "terraform-runner", "visibility": "private"}
+		}
+	}`)
+
+	profile, err := LoadSharedConfigProfile(path, "dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if profile.IBMCloudAPIKey != "dev-key" || profile.Region != "eu-de" || profile.ResourceGroup != "rg-dev" || profile.Visibility != "" {
+		t.Fatalf("bad profile: %+v", profile)
+	}
+	if !profile.HasCredentials() {
+		t.Fatalf("the dev profile sets an API key")
+	}
+
+	profile, err = LoadSharedConfigProfile(path, "runner")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if profile.ComputeResourceAuth != ComputeResourceAuthContainer || profile.IAMTrustedProfileName != "terraform-runner" || profile.Visibility != "private" {
+		t.Fatalf("bad profile: %+v", profile)
+	}
+}
+
+func TestLoadSharedConfigProfileYAML(t *testing.T) {
+	path := writeEndpointsFile(t, "config.yaml", `
+profiles:
+  classic:
+    iaas_classic_username: user
+    iaas_classic_api_key: classic-key
+    region: us-east
+`)
+
+	profile, err := LoadSharedConfigProfile(path, "classic")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if profile.IAASClassicUsername != "user" || profile.IAASClassicAPIKey != "classic-key" || profile.Region != "us-east" {
+		t.Fatalf("bad profile: %+v", profile)
+	}
+	if profile.HasCredentials() {
+		t.Fatalf("the classic profile sets no IBM Cloud platform credentials")
+	}
+}
+
+func TestLoadSharedConfigProfileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name, file, profile, expected string
+	}{
+		{"missing file", "", "dev", "Unable to read"},
+		{"malformed", `{"profiles": `, "dev", "Unable to parse"},
+		{"unknown key", `{"profiles": {"dev": {"ibmcloud_apikey": "key"}}}`, "dev", "ibmcloud_apikey"},
+		{"unknown profile", `{"profiles": {"dev": {}, "prod": {}}}`, "test", "found profiles: dev, prod"},
+		{"bad visibility", `{"profiles": {"dev": {"visibility": "internal"}}}`, "dev", "visibility"},
+		{"bad compute resource", `{"profiles": {"dev": {"compute_resource_auth": "lambda"}}}`, "dev", "compute_resource_auth"},
+		{"conflicting credentials", `{"profiles": {"dev": {"ibmcloud_api_key": "key", "compute_resource_auth": "vpc_instance"}}}`, "dev", "cannot be used together"},
+	} {
+		path := filepath.Join(t.TempDir(), "missing.json")
+		if tc.file != "" {
+			path = writeEndpointsFile(t, "config.json", tc.file)
+		}
+		_, err := LoadSharedConfigProfile(path, tc.profile)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Fatalf("%s: expected an error containing %q, got %v", tc.name, tc.expected, err)
+		}
+	}
+}
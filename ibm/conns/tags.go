@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"sort"
+	"strings"
+)
+
+// TagsConfig holds the provider level tagging settings. Default tags are
+// attached to every resource that supports user tags, in addition to the tags
+// declared by the resource; ignored tags are left alone when reading and
+// updating the tags of a resource.
+type TagsConfig struct {
+	DefaultTags       map[string]string
+	IgnoreKeys        []string
+	IgnoreKeyPrefixes []string
+}
+
+// Tags returns the default tags in their "key:value" form, sorted by key. A
+// default tag with an empty value is rendered as the bare key.
+func (t *TagsConfig) Tags() []string {
+	if t == nil || len(t.DefaultTags) == 0 {
+		return nil
+	}
+	tags := make([]string, 0, len(t.DefaultTags))
+	for k, v := range t.DefaultTags {
+		if v == "" {
+			tags = append(tags, k)
+			continue
+		}
+		tags = append(tags, k+":"+v)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// IsDefaultTag reports whether tag is one of the default tags.
+func (t *TagsConfig) IsDefaultTag(tag string) bool {
+	for _, d := range t.Tags() {
+		if d == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIgnoredTag reports whether the key of tag, the part before the first ':',
+// matches one of the ignored keys or key prefixes.
+func (t *TagsConfig) IsIgnoredTag(tag string) bool {
+	if t == nil {
+		return false
+	}
+	key := strings.TrimSpace(strings.SplitN(tag, ":", 2)[0])
+	for _, k := range t.IgnoreKeys {
+		if key == k {
+			return true
+		}
+	}
+	for _, p := range t.IgnoreKeyPrefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
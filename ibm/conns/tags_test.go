@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagsConfigTags(t *testing.T) {
+	c := &TagsConfig{
+		DefaultTags: map[string]string{
+			"env":   "dev",
+			"owner": "",
+			"cost":  "1234",
+		},
+	}
+	expected := []string{"cost:1234", "env:dev", "owner"}
+	if actual := c.Tags(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v\n\t%#v", actual, expected)
+	}
+	if !c.IsDefaultTag("env:dev") || c.IsDefaultTag("env:prod") {
+		t.Fatalf("bad default tag match for %#v", c.DefaultTags)
+	}
+
+	var nilConfig *TagsConfig
+	if tags := nilConfig.Tags(); tags != nil {
+		t.Fatalf("expected no default tags, got %#v", tags)
+	}
+}
+
+func TestTagsConfigIsIgnoredTag(t *testing.T) {
+	c := &TagsConfig{
+		IgnoreKeys:        []string{"schematics"},
+		IgnoreKeyPrefixes: []string{"ibm-"},
+	}
+	cases := map[string]bool{
+		"schematics:workspace": true,
+		"schematics":           true,
+		"ibm-managed:true":     true,
+		"env:dev":              false,
+		"my-schematics:x":      false,
+	}
+	for tag, expected := range cases {
+		if actual := c.IsIgnoredTag(tag); actual != expected {
+			t.Fatalf("IsIgnoredTag(%q) = %t, expected %t", tag, actual, expected)
+		}
+	}
+}
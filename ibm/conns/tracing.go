@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingEnvVar enables the OpenTelemetry tracing of the provider when set to
+// true. The spans are exported with OTLP over HTTP, to the collector set by
+// the standard OTEL_EXPORTER_OTLP_* environment variables, by default
+// http://localhost:4318.
+const TracingEnvVar = "IBMCLOUD_OTEL_TRACING"
+
+const tracerName = "github.com/IBM-Cloud/terraform-provider-ibm"
+
+// Attributes of the spans, in addition to the OpenTelemetry semantic conventions.
+const (
+	ResourceTypeAttribute  = attribute.Key("ibm.resource_type")
+	OperationAttribute     = attribute.Key("ibm.operation")
+	DataSourceAttribute    = attribute.Key("ibm.data_source")
+	ServiceAttribute       = attribute.Key("ibm.service")
+	RequestIDAttribute     = attribute.Key("ibm.request_id")
+	TransactionIDAttribute = attribute.Key("ibm.transaction_id")
+)
+
+// StartTracing sets up the export of the spans when TracingEnvVar is true.
+// The returned function flushes the pending spans and stops the export, and
+// must be called before the provider exits.
+func StartTracing(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+	if !tracingEnabled() {
+		return noop, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return noop, fmt.Errorf("[ERROR] Error creating the OTLP trace exporter: %s", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName("terraform-provider-ibm"),
+		semconv.ServiceVersion(version.Version),
+	))
+	if err != nil {
+		return noop, fmt.Errorf("[ERROR] Error creating the OpenTelemetry resource: %s", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// tracingEnabled reports whether TracingEnvVar is true.
+func tracingEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(TracingEnvVar))
+	return enabled
+}
+
+// StartOperationSpan starts the span of an operation (create, read, update,
+// delete...) of a resource type or data source. The spans of the API calls
+// made with the returned context are children of it.
+func StartOperationSpan(ctx context.Context, resourceType, operationName string, isDataSource bool) (context.Context, trace.Span) {
+	name := resourceType + " " + operationName
+	if isDataSource {
+		name = "data." + name
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(
+		ResourceTypeAttribute.String(resourceType),
+		OperationAttribute.String(operationName),
+		DataSourceAttribute.Bool(isDataSource),
+	))
+}
+
+// tracingTransport records a client span for every request of the clients of
+// a service, as a child of the span of the operation of its context, see
+// StartOperationSpan.
+type tracingTransport struct {
+	base    http.RoundTripper
+	service string
+}
+
+// tracedTransport returns base, traced as the requests of the named service
+// when the tracing is enabled.
+func tracedTransport(base http.RoundTripper, service string) http.RoundTripper {
+	if !tracingEnabled() {
+		return base
+	}
+	return &tracingTransport{base: base, service: service}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), req.Method+" "+t.service, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+	if !span.IsRecording() {
+		return t.base.RoundTrip(req)
+	}
+
+	span.SetAttributes(
+		ServiceAttribute.String(t.service),
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.URLFull(redactURL(req.URL)),
+		semconv.ServerAddress(req.URL.Hostname()),
+	)
+	if op, ok := operationFromContext(ctx); ok {
+		span.SetAttributes(ResourceTypeAttribute.String(op.resourceType), OperationAttribute.String(op.name))
+	}
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		span.SetAttributes(RequestIDAttribute.String(id))
+	}
+	if id := resp.Header.Get("Transaction-Id"); id != "" {
+		span.SetAttributes(TransactionIDAttribute.String(id))
+	}
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, err
+}
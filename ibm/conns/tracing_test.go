@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingTransport(t *testing.T) {
+	t.Setenv(TracingEnvVar, "true")
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("Transaction-Id", "txn-1")
+	}))
+	defer server.Close()
+
+	sess := &Session{Transport: &http.Transport{}}
+	client := &http.Client{Transport: sess.serviceTransport("vpc")}
+
+	ctx, span := StartOperationSpan(context.Background(), "ibm_is_vpc", "create", false)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/vpcs", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	request, operation := spans[0], spans[1]
+	if operation.Name() != "ibm_is_vpc create" || request.Name() != "POST vpc" {
+		t.Fatalf("unexpected spans %q and %q", operation.Name(), request.Name())
+	}
+	if request.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Fatalf("expected the request span to be a child of the operation span")
+	}
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, a := range request.Attributes() {
+		attributes[a.Key] = a.Value
+	}
+	for key, expected := range map[attribute.Key]string{
+		ServiceAttribute:       "vpc",
+		RequestIDAttribute:     "req-1",
+		TransactionIDAttribute: "txn-1",
+	} {
+		if attributes[key].AsString() != expected {
+			t.Errorf("expected %s to be %s, got %s", key, expected, attributes[key].AsString())
+		}
+	}
+	if attributes["http.response.status_code"].AsInt64() != http.StatusOK {
+		t.Errorf("expected the status code to be recorded, got %v", attributes["http.response.status_code"])
+	}
+}
+
+func TestTracingTransportWithoutContext(t *testing.T) {
+	t.Setenv(TracingEnvVar, "true")
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	sess := &Session{Transport: &http.Transport{}}
+	bmxSess := &bxsession.Session{Config: &bluemix.Config{
+		HTTPClient: &http.Client{Transport: sess.serviceTransport(BluemixServiceName)},
+	}}
+	get := func(client *http.Client) {
+		resp, err := client.Get(server.URL + "/v2/getDedicatedHostPools")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	ctx, span := StartOperationSpan(context.Background(), "ibm_container_dedicated_host_pool", "read", false)
+	ctx = WithOperation(ctx, "ibm_container_dedicated_host_pool", "read")
+	get(BluemixSessionWithContext(ctx, bmxSess).Config.HTTPClient)
+	// The requests made without the context are not attributed to the
+	// operation in progress
+	get(bmxSess.Config.HTTPClient)
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	request, withoutContext, operation := spans[0], spans[1], spans[2]
+	if operation.Name() != "ibm_container_dedicated_host_pool read" {
+		t.Fatalf("unexpected operation span %q", operation.Name())
+	}
+	if request.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Fatalf("expected the request span to be a child of the operation span")
+	}
+	attributes := map[attribute.Key]string{}
+	for _, a := range request.Attributes() {
+		attributes[a.Key] = a.Value.Emit()
+	}
+	if attributes[ResourceTypeAttribute] != "ibm_container_dedicated_host_pool" || attributes[OperationAttribute] != "read" {
+		t.Fatalf("expected the request span to be attributed to the operation, got %v", attributes)
+	}
+	if withoutContext.Parent().IsValid() {
+		t.Fatalf("expected the request made without the context to be a root span")
+	}
+	for _, a := range withoutContext.Attributes() {
+		if a.Key == ResourceTypeAttribute || a.Key == OperationAttribute {
+			t.Fatalf("expected the request made without the context not to be attributed, got %v", a)
+		}
+	}
+}
+
+func TestTracingTransportDisabled(t *testing.T) {
+	t.Setenv(TracingEnvVar, "false")
+	base := &sharedTransport{base: http.DefaultTransport}
+	if transport := tracedTransport(base, "vpc"); transport != base {
+		t.Fatalf("expected the requests not to be traced, got %T", transport)
+	}
+	sess := &Session{RateLimit: RateLimitConfig{MaxInFlight: 1}}
+	if _, ok := sess.serviceTransport("vpc").(*limitedTransport); !ok {
+		t.Fatalf("expected the transport of the service not to be traced")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Defaults of the shared transport settings, used when the provider leaves
+// them unset.
+const (
+	DefaultMaxIdleConns        = 100
+	DefaultMaxIdleConnsPerHost = 20
+	DefaultIdleConnTimeout     = 90 * time.Second
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+// newTransport returns the connection pooled transport shared by all the
+// service clients of a session.
+func newTransport(c *Config) (*http.Transport, error) {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          c.MaxIdleConns,
+		MaxIdleConnsPerHost:   c.MaxIdleConnsPerHost,
+		IdleConnTimeout:       c.IdleConnTimeout,
+		TLSHandshakeTimeout:   c.TLSHandshakeTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	if transport.MaxIdleConns <= 0 {
+		transport.MaxIdleConns = DefaultMaxIdleConns
+	}
+	if transport.MaxIdleConnsPerHost <= 0 {
+		transport.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if transport.IdleConnTimeout <= 0 {
+		transport.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	if transport.TLSHandshakeTimeout <= 0 {
+		transport.TLSHandshakeTimeout = DefaultTLSHandshakeTimeout
+	}
+
+	if c.HTTPSProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPSProxy)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("[ERROR] Invalid https_proxy %q: expected a URL such as http://proxy.example.com:3128", c.HTTPSProxy)
+		}
+		// The hosts of NO_PROXY, such as the private endpoints, and the
+		// http requests keep the proxy settings of the environment
+		proxyConfig := httpproxy.FromEnvironment()
+		proxyConfig.HTTPSProxy = proxyURL.String()
+		proxyFunc := proxyConfig.ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	if c.CABundleFile != "" {
+		pem, err := os.ReadFile(c.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error reading ca_bundle_file %s: %s", c.CABundleFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("[ERROR] No PEM encoded certificates found in ca_bundle_file %s", c.CABundleFile)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	return transport, nil
+}
+
+// TransportMiddleware, when set, wraps the shared transport of the sessions
+// created afterwards, e.g. to record or replay the API traffic of the
+// acceptance tests.
+var TransportMiddleware func(http.RoundTripper) http.RoundTripper
+
+// baseTransport returns the shared transport of the session, wrapped by
+// TransportMiddleware, recorded to the audit log when one is configured,
+// behind the read-only guard when ReadOnly is set and with the IAM token of
+// the compute resource refreshed when compute_resource_auth is configured.
+func (s *Session) baseTransport() http.RoundTripper {
+	transport := http.RoundTripper(s.Transport)
+	if s.middleware != nil {
+		transport = s.middleware
+	}
+	if s.auditLog != nil {
+		transport = &auditTransport{base: transport, log: s.auditLog}
+	}
+	if s.ReadOnly {
+		transport = &readOnlyTransport{base: transport}
+	}
+	if s.computeResourceAuth != nil {
+		transport = &computeResourceAuthTransport{base: transport, token: s.computeResourceAuth}
+	}
+	return transport
+}
+
+// sharedTransport hands the requests of a service client to the shared
+// transport. Keeping the shared *http.Transport behind this type stops the
+// SDKs from adjusting its settings when they adopt the client.
+type sharedTransport struct {
+	base http.RoundTripper
+}
+
+func (t *sharedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req)
+}
+
+// iamClient returns the client of the IAM token requests, backed by the
+// shared transport of the session, so that they honor ca_bundle_file,
+// https_proxy and TransportMiddleware like the requests of the services.
+func (s *Session) iamClient() *http.Client {
+	return &http.Client{Transport: s.serviceTransport("iam"), Timeout: s.HTTPTimeout}
+}
+
+// HTTPClient returns a client backed by the shared transport of the session,
+// for use by the go-sdk-core based service clients.
+func (s *Session) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &sharedTransport{base: s.baseTransport()},
+		Timeout:   s.HTTPTimeout,
+	}
+}
+
+// BluemixSessionWithContext returns a copy of the bluemix-go session sess
+// whose API calls carry the resource operation and the span of ctx, see
+// WithOperation and StartOperationSpan. bluemix-go makes its API calls without
+// a context: the clients created from the copy, such as
+// containerv2.New(BluemixSessionWithContext(ctx, sess)), attribute them to
+// the operation of ctx in the audit log and the traces.
+func BluemixSessionWithContext(ctx context.Context, sess *bxsession.Session) *bxsession.Session {
+	config := sess.Config.Copy()
+	client := &http.Client{}
+	if config.HTTPClient != nil {
+		*client = *config.HTTPClient
+	}
+	client.Transport = &operationTransport{base: client.Transport, ctx: ctx}
+	config.HTTPClient = client
+	return &bxsession.Session{Config: config}
+}
+
+// operationTransport sends the requests with the resource operation and the
+// span of ctx. They keep the cancellation and the deadline of their own
+// context, such as the timeout of their client.
+type operationTransport struct {
+	base http.RoundTripper
+	ctx  context.Context
+}
+
+func (t *operationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	ctx := req.Context()
+	if op, ok := operationFromContext(t.ctx); ok {
+		ctx = context.WithValue(ctx, operationKey{}, op)
+	}
+	if span := trace.SpanFromContext(t.ctx); span.SpanContext().IsValid() {
+		ctx = trace.ContextWithSpan(ctx, span)
+	}
+	return base.RoundTrip(req.WithContext(ctx))
+}
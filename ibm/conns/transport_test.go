@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewTransportDefaults(t *testing.T) {
+	transport, err := newTransport(&Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if transport.DisableKeepAlives {
+		t.Fatal("expected keep-alives to be enabled")
+	}
+	if transport.MaxIdleConns != DefaultMaxIdleConns || transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost {
+		t.Fatalf("bad idle connection limits: %d, %d", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != DefaultIdleConnTimeout || transport.TLSHandshakeTimeout != DefaultTLSHandshakeTimeout {
+		t.Fatalf("bad timeouts: %s, %s", transport.IdleConnTimeout, transport.TLSHandshakeTimeout)
+	}
+}
+
+func TestNewTransportSettings(t *testing.T) {
+	transport, err := newTransport(&Config{
+		MaxIdleConns:        10,
+		MaxIdleConnsPerHost: 5,
+		IdleConnTimeout:     time.Minute,
+		TLSHandshakeTimeout: 3 * time.Second,
+		HTTPSProxy:          "http://proxy.example.com:3128",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if transport.MaxIdleConns != 10 || transport.MaxIdleConnsPerHost != 5 {
+		t.Fatalf("bad idle connection limits: %d, %d", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+	}
+
+	req, _ := http.NewRequest("GET", "https://iam.cloud.ibm.com/identity/token", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Fatalf("bad proxy %v: %v", proxy, err)
+	}
+}
+
+func TestNewTransportNoProxy(t *testing.T) {
+	for _, name := range []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "no_proxy", "REQUEST_METHOD"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("NO_PROXY", ".private.cloud.ibm.com")
+	transport, err := newTransport(&Config{HTTPSProxy: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := map[string]bool{
+		"https://iam.cloud.ibm.com/identity/token":                    true,
+		"https://us-south.iaas.private.cloud.ibm.com/v1/vpcs":         false,
+		"http://us-south.containers.cloud.ibm.com/global/v1/clusters": false,
+	}
+	for rawURL, proxied := range cases {
+		req, _ := http.NewRequest("GET", rawURL, nil)
+		proxy, err := transport.Proxy(req)
+		if err != nil || (proxy != nil) != proxied {
+			t.Fatalf("%s: expected proxied %t, got %v: %v", rawURL, proxied, proxy, err)
+		}
+	}
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	if _, err := newTransport(&Config{HTTPSProxy: "proxy"}); err == nil {
+		t.Fatal("expected an error for an https_proxy without host")
+	}
+	if _, err := newTransport(&Config{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+		t.Fatal("expected an error for a missing ca_bundle_file")
+	}
+
+	file := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(file, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newTransport(&Config{CABundleFile: file}); err == nil {
+		t.Fatal("expected an error for a ca_bundle_file without certificates")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// TagsAll is the computed attribute holding the tags of a resource merged
+// with the provider default tags.
+const TagsAll = "tags_all"
+
+func providerTagsConfig(meta interface{}) *conns.TagsConfig {
+	if sess, ok := meta.(conns.ClientSession); ok {
+		return sess.TagsConfig()
+	}
+	return nil
+}
+
+// MergeDefaultTags returns a copy of tags with the provider default tags added.
+// A nil tags set is empty.
+func MergeDefaultTags(meta interface{}, tags *schema.Set) *schema.Set {
+	f := ResourceIBMVPCHash
+	var items []interface{}
+	if tags != nil {
+		if tags.F != nil {
+			f = tags.F
+		}
+		items = tags.List()
+	}
+	merged := schema.NewSet(f, items)
+	for _, t := range providerTagsConfig(meta).Tags() {
+		merged.Add(t)
+	}
+	return merged
+}
+
+// IsIgnoredTag reports whether tag is selected by the provider ignore_tags
+// setting and must be left alone by Terraform.
+func IsIgnoredTag(meta interface{}, tag string) bool {
+	return providerTagsConfig(meta).IsIgnoredTag(tag)
+}
+
+// RemoveIgnoredTags returns tags without the ones selected by the provider
+// ignore_tags setting.
+func RemoveIgnoredTags(meta interface{}, tags *schema.Set) *schema.Set {
+	if tags == nil {
+		return tags
+	}
+	config := providerTagsConfig(meta)
+	for _, t := range tags.List() {
+		if config.IsIgnoredTag(fmt.Sprint(t)) {
+			tags.Remove(t)
+		}
+	}
+	return tags
+}
+
+// SupportsDefaultTags reports whether a resource schema declares the
+// configurable "tags" string set handled by UpdateTagsUsingCRN and
+// UpdateGlobalTagsUsingCRN.
+func SupportsDefaultTags(s map[string]*schema.Schema) bool {
+	tags, ok := s["tags"]
+	if !ok || tags.Type != schema.TypeSet || !tags.Optional {
+		return false
+	}
+	if _, ok := s[TagsAll]; ok {
+		return false
+	}
+	elem, ok := tags.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+// TagsAllSchema returns the schema of the computed "tags_all" attribute.
+func TagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         ResourceIBMVPCHash,
+		Description: "The tags of the resource, including the default tags of the provider.",
+	}
+}
+
+// ResourceDefaultTagsCustomizeDiff plans "tags_all" as the resource tags merged
+// with the provider default tags, and suppresses the diff on "tags" when the
+// only difference is the default tags read back from the resource.
+func ResourceDefaultTagsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := providerTagsConfig(meta)
+
+	if diff.Id() != "" && diff.HasChange("tags") && len(config.Tags()) > 0 {
+		o, n := diff.GetChange("tags")
+		oldSet := o.(*schema.Set)
+		newSet := n.(*schema.Set)
+		removeInt := oldSet.Difference(newSet).List()
+		addInt := newSet.Difference(oldSet).List()
+		onlyDefaults := len(removeInt) > 0 && len(addInt) == 0
+		for _, v := range removeInt {
+			if !config.IsDefaultTag(fmt.Sprint(v)) {
+				onlyDefaults = false
+				break
+			}
+		}
+		if onlyDefaults {
+			if err := diff.Clear("tags"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if raw := diff.GetRawConfig(); !raw.IsNull() && raw.IsKnown() {
+		if tags := raw.GetAttr("tags"); !tags.IsKnown() {
+			return diff.SetNewComputed(TagsAll)
+		}
+	}
+	tags := diff.Get("tags").(*schema.Set)
+	return diff.SetNew(TagsAll, MergeDefaultTags(meta, tags))
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// tagsMeta is a client session that only carries the tagging settings.
+type tagsMeta struct {
+	conns.ClientSession
+	config *conns.TagsConfig
+}
+
+func (m tagsMeta) TagsConfig() *conns.TagsConfig {
+	return m.config
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	meta := tagsMeta{config: &conns.TagsConfig{
+		DefaultTags: map[string]string{"env": "dev", "team": ""},
+	}}
+	tags := NewStringSet(ResourceIBMVPCHash, []string{"app:web", "env:dev"})
+
+	merged := MergeDefaultTags(meta, tags)
+	assert.ElementsMatch(t, []interface{}{"app:web", "env:dev", "team"}, merged.List())
+	assert.Equal(t, 2, tags.Len())
+
+	merged = MergeDefaultTags(meta, new(schema.Set))
+	assert.ElementsMatch(t, []interface{}{"env:dev", "team"}, merged.List())
+
+	merged = MergeDefaultTags(meta, nil)
+	assert.ElementsMatch(t, []interface{}{"env:dev", "team"}, merged.List())
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	meta := tagsMeta{config: &conns.TagsConfig{
+		IgnoreKeys:        []string{"schematics"},
+		IgnoreKeyPrefixes: []string{"ibm-"},
+	}}
+	tags := NewStringSet(ResourceIBMVPCHash, []string{"app:web", "schematics:ws", "ibm-managed:true"})
+
+	assert.ElementsMatch(t, []interface{}{"app:web"}, RemoveIgnoredTags(meta, tags).List())
+	assert.True(t, IsIgnoredTag(meta, "ibm-owner"))
+	assert.False(t, IsIgnoredTag(nil, "ibm-owner"))
+}
+
+func TestSupportsDefaultTags(t *testing.T) {
+	tags := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	assert.True(t, SupportsDefaultTags(map[string]*schema.Schema{"tags": tags}))
+	assert.False(t, SupportsDefaultTags(map[string]*schema.Schema{"tags": tags, TagsAll: TagsAllSchema()}))
+	assert.False(t, SupportsDefaultTags(map[string]*schema.Schema{
+		"tags": {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}))
+	assert.False(t, SupportsDefaultTags(map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}))
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The placeholders of the import ID formats, replaced by the segments of the
+// CRN of the resource.
+const (
+	crnPlaceholder                = "{crn}"
+	regionPlaceholder             = "{region}"
+	accountPlaceholder            = "{account}"
+	serviceInstancePlaceholder    = "{service_instance}"
+	serviceInstanceCRNPlaceholder = "{service_instance_crn}"
+	resourcePlaceholder           = "{resource}"
+)
+
+// crnImportIDFormats maps the resource types whose ID is not the default one,
+// see ImportIDFromCRN, to the format of their ID. The keys ending with _ are
+// prefixes of resource types, the longest matching key wins.
+var crnImportIDFormats = map[string]string{
+	"ibm_resource_instance":             crnPlaceholder,
+	"ibm_resource_key":                  crnPlaceholder,
+	"ibm_resource_tag":                  crnPlaceholder,
+	"ibm_database":                      crnPlaceholder,
+	"ibm_kms_key":                       crnPlaceholder,
+	"ibm_kms_key_policies":              crnPlaceholder,
+	"ibm_kms_key_with_policy_overrides": crnPlaceholder,
+	"ibm_kms_instance_policies":         crnPlaceholder,
+	"ibm_kp_key":                        crnPlaceholder,
+	"ibm_event_streams_":                crnPlaceholder,
+	"ibm_appid_":                        serviceInstancePlaceholder,
+	"ibm_atracker_route":                serviceInstancePlaceholder,
+	"ibm_atracker_target":               serviceInstancePlaceholder,
+	"ibm_cd_toolchain":                  serviceInstancePlaceholder,
+	"ibm_code_engine_project":           serviceInstancePlaceholder,
+	"ibm_container_cluster":             serviceInstancePlaceholder,
+	"ibm_container_nlb_dns":             serviceInstancePlaceholder,
+	"ibm_container_vpc_cluster":         serviceInstancePlaceholder,
+	"ibm_metrics_router_route":          serviceInstancePlaceholder,
+	"ibm_metrics_router_target":         serviceInstancePlaceholder,
+	"ibm_cis_domain":                    resourcePlaceholder + ":" + serviceInstanceCRNPlaceholder,
+	"ibm_sm_":                           regionPlaceholder + "/" + serviceInstancePlaceholder + "/" + resourcePlaceholder,
+}
+
+// crnImportUnsupported maps the resource types that cannot be imported by CRN
+// to the import ID to use instead.
+var crnImportUnsupported = map[string]string{
+	"ibm_cos_bucket": "<bucket crn>:meta:<rl|crl|ssl>:<location>",
+}
+
+// IsImportCRN reports whether the import ID id is a CRN to resolve with
+// ImportIDFromCRN. The IDs starting with crn: made of more segments, such as
+// the IDs of the COS buckets, are not.
+func IsImportCRN(id string) bool {
+	if !strings.HasPrefix(id, crn+crnSeparator) {
+		return false
+	}
+	_, err := Parse(id)
+	return err == nil
+}
+
+// ImportIDFromCRN returns the ID of the resource of type resourceType with
+// the CRN crnString, to import the resource by CRN. By default the ID of a service
+// instance is its CRN, and the ID of a resource is the ID of the resource,
+// prefixed with the ID of its service instance when the CRN has one, as in
+// <service instance>/<resource>. The resource types with another ID are listed
+// in crnImportIDFormats.
+func ImportIDFromCRN(resourceType, crnString string) (string, error) {
+	c, err := Parse(crnString)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error parsing the CRN %s: %s", crnString, err)
+	}
+	if c.Scheme == "" {
+		return "", fmt.Errorf("[ERROR] Error parsing the CRN: the CRN is empty")
+	}
+	if id, ok := crnImportUnsupported[resourceType]; ok {
+		return "", fmt.Errorf("[ERROR] %s cannot be imported by CRN, use the import ID %s", resourceType, id)
+	}
+
+	format := crnImportIDFormat(resourceType)
+	if format == "" {
+		switch {
+		case c.ResourceType == "" && c.Resource == "":
+			format = crnPlaceholder
+		case c.ServiceInstance == "":
+			format = resourcePlaceholder
+		default:
+			format = serviceInstancePlaceholder + "/" + resourcePlaceholder
+		}
+	}
+	if strings.Contains(format, serviceInstancePlaceholder) && c.ServiceInstance == "" {
+		return "", fmt.Errorf("[ERROR] The CRN %s of %s has no service instance", crnString, resourceType)
+	}
+	if strings.Contains(format, resourcePlaceholder) && c.Resource == "" {
+		return "", fmt.Errorf("[ERROR] The CRN %s of %s has no resource", crnString, resourceType)
+	}
+
+	instance := c
+	instance.ResourceType, instance.Resource = "", ""
+	return strings.NewReplacer(
+		crnPlaceholder, crnString,
+		regionPlaceholder, c.Region,
+		accountPlaceholder, c.AccountID(),
+		serviceInstancePlaceholder, c.ServiceInstance,
+		serviceInstanceCRNPlaceholder, instance.String(),
+		resourcePlaceholder, c.Resource,
+	).Replace(format), nil
+}
+
+func crnImportIDFormat(resourceType string) string {
+	if format, ok := crnImportIDFormats[resourceType]; ok {
+		return format
+	}
+	var format, match string
+	for key, f := range crnImportIDFormats {
+		if strings.HasSuffix(key, "_") && strings.HasPrefix(resourceType, key) && len(key) > len(match) {
+			format, match = f, key
+		}
+	}
+	return format
+}
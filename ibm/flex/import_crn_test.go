@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportIDFromCRN(t *testing.T) {
+	cases := []struct {
+		resourceType, crn, id string
+	}{
+		{"ibm_is_vpc", "crn:v1:bluemix:public:is:us-south:a/acc::vpc:r006-1111", "r006-1111"},
+		{"ibm_is_instance", "crn:v1:bluemix:public:is:us-south-1:a/acc::instance:0717-2222", "0717-2222"},
+		{"ibm_iam_access_group", "crn:v1:bluemix:public:iam-groups::a/acc::access-group:AccessGroupId-1", "AccessGroupId-1"},
+		{"ibm_dns_zone", "crn:v1:bluemix:public:dns-svcs:global:a/acc:instance-1:zone:zone-1", "instance-1/zone-1"},
+		{"ibm_resource_instance", "crn:v1:bluemix:public:cloud-object-storage:global:a/acc:instance-1::", "crn:v1:bluemix:public:cloud-object-storage:global:a/acc:instance-1::"},
+		{"ibm_resource_key", "crn:v1:bluemix:public:cloud-object-storage:global:a/acc:instance-1:resource-key:key-1", "crn:v1:bluemix:public:cloud-object-storage:global:a/acc:instance-1:resource-key:key-1"},
+		{"ibm_event_streams_topic", "crn:v1:bluemix:public:messagehub:us-south:a/acc:instance-1:topic:orders", "crn:v1:bluemix:public:messagehub:us-south:a/acc:instance-1:topic:orders"},
+		{"ibm_atracker_target", "crn:v1:bluemix:public:atracker:us-south:a/acc:target-1::", "target-1"},
+		{"ibm_container_vpc_cluster", "crn:v1:bluemix:public:containers-kubernetes:us-south:a/acc:cluster-1::", "cluster-1"},
+		{"ibm_sm_arbitrary_secret", "crn:v1:bluemix:public:secrets-manager:eu-de:a/acc:instance-1:secret:secret-1", "eu-de/instance-1/secret-1"},
+		{"ibm_cis_domain", "crn:v1:bluemix:public:internet-svcs:global:a/acc:instance-1:domain:zone-1", "zone-1:crn:v1:bluemix:public:internet-svcs:global:a/acc:instance-1::"},
+	}
+	for _, c := range cases {
+		id, err := ImportIDFromCRN(c.resourceType, c.crn)
+		assert.NoError(t, err, c.resourceType)
+		assert.Equal(t, c.id, id, c.resourceType)
+	}
+}
+
+func TestImportIDFromCRNErrors(t *testing.T) {
+	_, err := ImportIDFromCRN("ibm_cos_bucket", "crn:v1:bluemix:public:cloud-object-storage:global:a/acc:instance-1:bucket:logs")
+	assert.ErrorContains(t, err, "cannot be imported by CRN")
+
+	_, err = ImportIDFromCRN("ibm_sm_arbitrary_secret", "crn:v1:bluemix:public:secrets-manager:eu-de:a/acc:instance-1::")
+	assert.ErrorContains(t, err, "has no resource")
+
+	_, err = ImportIDFromCRN("ibm_is_vpc", "crn:v1:bluemix:public:is:us-south")
+	assert.Error(t, err)
+}
+
+func TestIsImportCRN(t *testing.T) {
+	assert.True(t, IsImportCRN("crn:v1:bluemix:public:is:us-south:a/acc::vpc:r006-1111"))
+	assert.False(t, IsImportCRN("crn:v1:bluemix:public:cloud-object-storage:global:a/acc:instance-1:bucket:logs:meta:crl:eu"))
+	assert.False(t, IsImportCRN("r006-1111"))
+}
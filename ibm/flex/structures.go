@@ -2646,18 +2646,42 @@ func Parse(s string) (CRN, error) {
 
 	return crn, nil
 }
+
+// String composes the CRN back from its segments.
+func (c CRN) String() string {
+	scope := c.Scope
+	if c.ScopeType != "" {
+		scope = c.ScopeType + scopeSeparator + c.Scope
+	}
+	return strings.Join([]string{
+		c.Scheme, c.Version, c.CName, c.CType, c.ServiceName, c.Region,
+		scope, c.ServiceInstance, c.ResourceType, c.Resource,
+	}, crnSeparator)
+}
+
+// Location returns the location of the resource, its region prefixed with
+// the cloud name outside of the public and staging clouds, as GetLocation.
+func (c CRN) Location() string {
+	if c.CName == "bluemix" || c.CName == "staging" {
+		return c.Region
+	}
+	return c.CName + "-" + c.Region
+}
+
+// AccountID returns the ID of the account of an account scoped CRN.
+func (c CRN) AccountID() string {
+	if c.ScopeType == "a" {
+		return c.Scope
+	}
+	return ""
+}
+
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
 		log.Fatal(err)
 	}
-	region := crn.Region
-	cName := crn.CName
-	if cName == "bluemix" || cName == "staging" {
-		return region
-	} else {
-		return cName + "-" + region
-	}
+	return crn.Location()
 }
 
 func GetTags(d *schema.ResourceData, meta interface{}) error {
@@ -2730,6 +2754,9 @@ func GetGlobalTagsUsingCRN(meta interface{}, resourceID, resourceType, tagType s
 	if err != nil {
 		return nil, err
 	}
+	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+		taggingResult = RemoveIgnoredTags(meta, taggingResult)
+	}
 	return taggingResult, nil
 }
 
@@ -2838,18 +2865,25 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	}
 	olds := oldList.(*schema.Set)
 	news := newList.(*schema.Set)
+	userTags := strings.TrimSpace(tagType) == "" || tagType == "user"
+	if userTags {
+		news = MergeDefaultTags(meta, news)
+	}
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
 	for i, v := range addInt {
 		add[i] = fmt.Sprint(v)
 	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
+	remove := make([]string, 0, len(removeInt))
+	for _, v := range removeInt {
+		if userTags && IsIgnoredTag(meta, fmt.Sprint(v)) {
+			continue
+		}
+		remove = append(remove, fmt.Sprint(v))
 	}
 
-	if strings.TrimSpace(tagType) == "" || tagType == "user" {
+	if userTags {
 		schematicTags := os.Getenv("IC_ENV_TAGS")
 		var envTags []string
 		if schematicTags != "" {
@@ -2972,7 +3006,7 @@ func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error)
 	if err != nil {
 		return nil, err
 	}
-	return taggingResult, nil
+	return RemoveIgnoredTags(meta, taggingResult), nil
 }
 
 func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
@@ -2987,16 +3021,19 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := MergeDefaultTags(meta, newList.(*schema.Set))
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
 	for i, v := range addInt {
 		add[i] = fmt.Sprint(v)
 	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
+	remove := make([]string, 0, len(removeInt))
+	for _, v := range removeInt {
+		if IsIgnoredTag(meta, fmt.Sprint(v)) {
+			continue
+		}
+		remove = append(remove, fmt.Sprint(v))
 	}
 
 	schematicTags := os.Getenv("IC_ENV_TAGS")
@@ -3230,17 +3267,6 @@ func ResourceVolumeAttachmentValidate(diff *schema.ResourceDiff) error {
 	return nil
 }
 
-func ResourceIPSecPolicyValidate(diff *schema.ResourceDiff) error {
-
-	newEncAlgo := diff.Get("encryption_algorithm").(string)
-	newAuthAlgo := diff.Get("authentication_algorithm").(string)
-	if (newEncAlgo == "aes128gcm16" || newEncAlgo == "aes192gcm16" || newEncAlgo == "aes256gcm16") && newAuthAlgo != "disabled" {
-		return fmt.Errorf("authentication_algorithm must be set to 'disabled' when the encryption_algorithm is either one of 'aes128gcm16', 'aes192gcm16', 'aes256gcm16'")
-	}
-
-	return nil
-}
-
 func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 
 	if diff.Id() != "" && diff.HasChange("capacity") {
@@ -84,3 +84,31 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestCRNString(t *testing.T) {
+	for _, s := range []string{
+		"crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:2f3c4c6e-0000-4000-8000-000000000000:key:8a0b",
+		"crn:v1:bluemix:public:iam-identity::a/0123456789abcdef::apikey:ApiKey-1",
+		"crn:v1:bluemix:public:globalcatalog::global:::",
+	} {
+		crn, err := Parse(s)
+		assert.NoError(t, err)
+		assert.Equal(t, s, crn.String())
+	}
+}
+
+func TestCRNLocation(t *testing.T) {
+	crn, err := Parse("crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:instance::")
+	assert.NoError(t, err)
+	assert.Equal(t, "us-south", crn.Location())
+	assert.Equal(t, "0123456789abcdef", crn.AccountID())
+
+	crn, err = Parse("crn:v1:staging:public:kms:us-south:s/space::key:")
+	assert.NoError(t, err)
+	assert.Equal(t, "us-south", crn.Location())
+	assert.Equal(t, "", crn.AccountID())
+
+	crn, err = Parse("crn:v1:mycloud:dedicated:kms:eu-de:a/0123456789abcdef:instance::")
+	assert.NoError(t, err)
+	assert.Equal(t, "mycloud-eu-de", crn.Location())
+}
@@ -3,9 +3,15 @@ package flex
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -16,6 +22,15 @@ type TerraformProblem struct {
 
 	Resource  string
 	Operation string
+
+	// Attribute is the argument the problem is about, if known, as a
+	// dotted path such as "cloudlogs_endpoint.0.target_crn".
+	Attribute string
+
+	// Remediation and DocsURL tell the user how to solve the problem, they
+	// are set from the status and error code of the caused by error.
+	Remediation string
+	DocsURL     string
 }
 
 // GetID returns a hash value computed from stable fields in the
@@ -32,8 +47,9 @@ func (e *TerraformProblem) GetConsoleMessage() string {
 
 // GetConsoleMessage returns the fields of the problem that
 // are relevant to a developer, formatted as a YAML string.
+// Credentials in the message are masked, as it is logged.
 func (e *TerraformProblem) GetDebugMessage() string {
-	return core.ComputeDebugMessage(e)
+	return conns.RedactSecrets(core.ComputeDebugMessage(e))
 }
 
 func (e *TerraformProblem) GetConsoleOrderedMaps() *core.OrderedMaps {
@@ -44,11 +60,57 @@ func (e *TerraformProblem) GetConsoleOrderedMaps() *core.OrderedMaps {
 	orderedMaps.Add("severity", e.Severity)
 	orderedMaps.Add("resource", e.Resource)
 	orderedMaps.Add("operation", e.Operation)
+	if e.Attribute != "" {
+		orderedMaps.Add("attribute", e.Attribute)
+	}
+	if e.Remediation != "" {
+		orderedMaps.Add("remediation", e.Remediation)
+	}
+	if e.DocsURL != "" {
+		orderedMaps.Add("docs", e.DocsURL)
+	}
 	orderedMaps.Add("component", e.Component)
 
 	return orderedMaps
 }
 
+// WithAttribute sets the argument the problem is about, so that Terraform
+// points at it in the configuration, and returns the problem.
+func (e *TerraformProblem) WithAttribute(attribute string) *TerraformProblem {
+	e.Attribute = attribute
+	return e
+}
+
+// IsWarning reports whether the problem has "warning" level severity.
+func (e *TerraformProblem) IsWarning() bool {
+	return e.Severity == core.WarningSeverity
+}
+
+// DiagSeverity returns the severity of the problem as a diagnostic severity.
+func (e *TerraformProblem) DiagSeverity() diag.Severity {
+	if e.IsWarning() {
+		return diag.Warning
+	}
+	return diag.Error
+}
+
+// AttributePath returns the path of the argument of the problem, nil when it
+// is not known. The numeric steps of Attribute are list indexes.
+func (e *TerraformProblem) AttributePath() cty.Path {
+	if e.Attribute == "" {
+		return nil
+	}
+	var p cty.Path
+	for _, step := range strings.Split(e.Attribute, ".") {
+		if i, err := strconv.Atoi(step); err == nil {
+			p = p.IndexInt(i)
+		} else {
+			p = p.GetAttr(step)
+		}
+	}
+	return p
+}
+
 func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 	orderedMaps := e.GetConsoleOrderedMaps()
 
@@ -63,8 +125,40 @@ func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 // GetDiag returns a new Diagnostics object using the console
 // message as the summary. It is used to create a Diagnostics
 // object from a TerraformProblem in the resource/data source code.
+// The diagnostic has the severity and the attribute of the problem.
 func (e *TerraformProblem) GetDiag() diag.Diagnostics {
-	return diag.Errorf("%s", e.GetConsoleMessage())
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      e.DiagSeverity(),
+			Summary:       e.GetConsoleMessage(),
+			AttributePath: e.AttributePath(),
+		},
+	}
+}
+
+// GetFrameworkDiag returns the same diagnostic as GetDiag, for the resources
+// of the plugin framework provider.
+func (e *TerraformProblem) GetFrameworkDiag() fwdiag.Diagnostic {
+	if e.Attribute != "" {
+		var p path.Path
+		for i, step := range strings.Split(e.Attribute, ".") {
+			if n, err := strconv.Atoi(step); i == 0 {
+				p = path.Root(step)
+			} else if err == nil {
+				p = p.AtListIndex(n)
+			} else {
+				p = p.AtName(step)
+			}
+		}
+		if e.IsWarning() {
+			return fwdiag.NewAttributeWarningDiagnostic(p, e.GetConsoleMessage(), "")
+		}
+		return fwdiag.NewAttributeErrorDiagnostic(p, e.GetConsoleMessage(), "")
+	}
+	if e.IsWarning() {
+		return fwdiag.NewWarningDiagnostic(e.GetConsoleMessage(), "")
+	}
+	return fwdiag.NewErrorDiagnostic(e.GetConsoleMessage(), "")
 }
 
 // TerraformErrorf creates and returns a new instance of `TerraformProblem`
@@ -81,11 +175,30 @@ func TerraformErrorf(err error, summary, resource, operation string) *TerraformP
 // a discriminator used to make the instance unique relative to
 // other problem scenarios in the same resource/operation.
 func DiscriminatedTerraformErrorf(err error, summary, resource, operation, discriminator string) *TerraformProblem {
-	return &TerraformProblem{
+	problem := &TerraformProblem{
 		IBMProblem: core.IBMErrorf(err, getComponentInfo(), summary, discriminator),
 		Resource:   resource,
 		Operation:  operation,
 	}
+	problem.Remediation, problem.DocsURL = remediationOf(err)
+	return problem
+}
+
+// TerraformWarningf creates and returns a new instance of `TerraformProblem`
+// with "warning" level severity, for problems that do not fail the
+// operation, such as deprecated values, tags that could not be updated or
+// resources not visible yet after retries.
+func TerraformWarningf(err error, summary, resource, operation string) *TerraformProblem {
+	return DiscriminatedTerraformWarningf(err, summary, resource, operation, "")
+}
+
+// DiscriminatedTerraformWarningf creates and returns a new instance of
+// `TerraformProblem` with "warning" level severity that contains a
+// discriminator, see DiscriminatedTerraformErrorf.
+func DiscriminatedTerraformWarningf(err error, summary, resource, operation, discriminator string) *TerraformProblem {
+	problem := DiscriminatedTerraformErrorf(err, summary, resource, operation, discriminator)
+	problem.Severity = core.WarningSeverity
+	return problem
 }
 
 func getComponentInfo() *core.ProblemComponent {
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// remediationHint tells the user how to solve a problem caused by a service
+// error, and where to read more about it.
+type remediationHint struct {
+	remediation string
+	docsURL     string
+}
+
+var (
+	quotaExceededHint = remediationHint{
+		remediation: "A quota of the account is exceeded. Delete the resources that are no longer used, or request a quota increase, then apply again.",
+		docsURL:     "https://cloud.ibm.com/docs/account?topic=account-resource-quotas",
+	}
+	missingIAMRoleHint = remediationHint{
+		remediation: "The API key or trusted profile of the provider is missing an IAM role for this operation. Ask an administrator of the account to assign the role on the resource, see the required roles in the documentation of the resource.",
+		docsURL:     "https://cloud.ibm.com/docs/account?topic=account-assign-access-resources",
+	}
+	conflictHint = remediationHint{
+		remediation: "The resource already exists or is being changed by another operation. Wait for the other operation to complete and apply again, or import the existing resource.",
+		docsURL:     "https://developer.hashicorp.com/terraform/cli/import",
+	}
+)
+
+// requestFailure is implemented by the errors of the bluemix-go clients.
+type requestFailure interface {
+	StatusCode() int
+	Code() string
+}
+
+// remediationOf returns the remediation and the documentation link of the
+// problems caused by err, empty strings when err is not a known service
+// error. A quota exceeded is recognized by its error code or message, whatever
+// the status code.
+func remediationOf(err error) (string, string) {
+	if err == nil {
+		return "", ""
+	}
+	statusCode, errorCode := serviceErrorCodes(err)
+
+	var hint remediationHint
+	switch {
+	case strings.Contains(strings.ToLower(errorCode), "quota") || strings.Contains(strings.ToLower(err.Error()), "quota exceeded"):
+		hint = quotaExceededHint
+	case statusCode == http.StatusForbidden:
+		hint = missingIAMRoleHint
+	case statusCode == http.StatusConflict:
+		hint = conflictHint
+	}
+	return hint.remediation, hint.docsURL
+}
+
+// serviceErrorCodes returns the HTTP status code and the error code of the
+// service error in the chain of err, zero values when there is none.
+func serviceErrorCodes(err error) (int, string) {
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) && httpProblem.Response != nil {
+		errorCode := ""
+		if result, ok := httpProblem.Response.Result.(map[string]interface{}); ok {
+			errorCode = resultErrorCode(result)
+		}
+		return httpProblem.Response.StatusCode, errorCode
+	}
+	var failure requestFailure
+	if errors.As(err, &failure) {
+		return failure.StatusCode(), failure.Code()
+	}
+	return 0, ""
+}
+
+// resultErrorCode returns the error code of an error response body, in either
+// the {"errors": [{"code": ...}]} or the {"code": ...} shape.
+func resultErrorCode(result map[string]interface{}) string {
+	if errs, ok := result["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+	for _, key := range []string{"code", "error_code", "errorCode"} {
+		if code, ok := result[key].(string); ok {
+			return code
+		}
+	}
+	return ""
+}
@@ -7,6 +7,8 @@ import (
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -59,6 +61,14 @@ component:
 	assert.Equal(t, expected, message)
 }
 
+func TestTerraformProblemGetDebugMessageRedactsSecrets(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	terraformProb.Summary = `Create failed: {"name":"db","adminpassword":"secret-value"}`
+	message := terraformProb.GetDebugMessage()
+	assert.NotContains(t, message, "secret-value")
+	assert.Contains(t, message, `"adminpassword":"REDACTED"`)
+}
+
 func TestTerraformProblemGetID(t *testing.T) {
 	terraformProb := getPopulatedTerraformProblem()
 	assert.Equal(t, "terraform-98c0e1fd", terraformProb.GetID())
@@ -214,6 +224,70 @@ func TestDiscriminatedTerraformErrorf(t *testing.T) {
 	assert.NotEqual(t, terraformProbNoDisc.GetID(), terraformProb.GetID())
 }
 
+func TestTerraformWarningf(t *testing.T) {
+	terraformProb := TerraformWarningf(nil, "Tags not updated.", "ibm_some_resource", "update").WithAttribute("tags")
+	assert.Equal(t, core.WarningSeverity, terraformProb.Severity)
+
+	diagnostics := terraformProb.GetDiag()
+	assert.False(t, diagnostics.HasError())
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, diag.Warning, diagnostics[0].Severity)
+	assert.Equal(t, cty.GetAttrPath("tags"), diagnostics[0].AttributePath)
+	assert.Contains(t, diagnostics[0].Summary, "severity: warning")
+	assert.Contains(t, diagnostics[0].Summary, "attribute: tags")
+
+	terraformProbNoWarning := TerraformErrorf(nil, "Tags not updated.", "ibm_some_resource", "update")
+	assert.Equal(t, core.ErrorSeverity, terraformProbNoWarning.Severity)
+	assert.True(t, terraformProbNoWarning.GetDiag().HasError())
+}
+
+func TestTerraformProblemAttributePath(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	assert.Nil(t, terraformProb.AttributePath())
+	assert.Nil(t, terraformProb.GetDiag()[0].AttributePath)
+
+	terraformProb.WithAttribute("cloudlogs_endpoint.0.target_crn")
+	assert.Equal(t, cty.GetAttrPath("cloudlogs_endpoint").IndexInt(0).GetAttr("target_crn"), terraformProb.AttributePath())
+}
+
+func TestTerraformErrorfRemediation(t *testing.T) {
+	httpProblem := func(statusCode int, result map[string]interface{}) error {
+		return &core.HTTPProblem{
+			IBMProblem: &core.IBMProblem{Summary: "Request failed."},
+			Response:   &core.DetailedResponse{StatusCode: statusCode, Result: result},
+		}
+	}
+
+	forbidden := TerraformErrorf(httpProblem(403, nil), "Create failed.", "ibm_some_resource", "create")
+	assert.Equal(t, missingIAMRoleHint.remediation, forbidden.Remediation)
+	assert.Equal(t, missingIAMRoleHint.docsURL, forbidden.DocsURL)
+	assert.Contains(t, forbidden.GetConsoleMessage(), "docs: "+missingIAMRoleHint.docsURL)
+
+	conflict := TerraformErrorf(httpProblem(409, nil), "Create failed.", "ibm_some_resource", "create")
+	assert.Equal(t, conflictHint.remediation, conflict.Remediation)
+
+	quota := TerraformErrorf(httpProblem(400, map[string]interface{}{
+		"errors": []interface{}{map[string]interface{}{"code": "over_quota", "message": "Quota exceeded."}},
+	}), "Create failed.", "ibm_some_resource", "create")
+	assert.Equal(t, quotaExceededHint.remediation, quota.Remediation)
+
+	bmxQuota := FmtErrorf("Create failed: %s", &mockRequestFailure{statusCode: 409, code: "QuotaExceeded"})
+	assert.Equal(t, quotaExceededHint.remediation, bmxQuota.(*TerraformProblem).Remediation)
+
+	notFound := TerraformErrorf(httpProblem(404, nil), "Read failed.", "ibm_some_resource", "read")
+	assert.Empty(t, notFound.Remediation)
+	assert.NotContains(t, notFound.GetConsoleMessage(), "remediation")
+}
+
+type mockRequestFailure struct {
+	statusCode int
+	code       string
+}
+
+func (e *mockRequestFailure) Error() string   { return e.code }
+func (e *mockRequestFailure) StatusCode() int { return e.statusCode }
+func (e *mockRequestFailure) Code() string    { return e.code }
+
 func TestGetComponentInfo(t *testing.T) {
 	component := getComponentInfo()
 	assert.NotNil(t, component)
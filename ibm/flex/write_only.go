@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// GetWriteOnlyString returns the value of the write-only string attribute key
+// of the raw configuration of a resource, see ResourceData.GetRawConfig.
+// Write-only attributes are never persisted to the plan or the state, so
+// ResourceData.Get always returns their zero value. It returns an empty string
+// when the attribute is not set or its value is not known yet.
+func GetWriteOnlyString(rawConfig cty.Value, key string) string {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(key) {
+		return ""
+	}
+	return writeOnlyString(rawConfig.GetAttr(key))
+}
+
+func writeOnlyString(v cty.Value) string {
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+	return v.AsString()
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWriteOnlyString(t *testing.T) {
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"password_wo":  cty.StringVal("secret"),
+		"payload_wo":   cty.NullVal(cty.String),
+		"apikey_wo":    cty.UnknownVal(cty.String),
+		"name":         cty.StringVal("name"),
+		"wo_version":   cty.NumberIntVal(1),
+		"unrelated_wo": cty.ListValEmpty(cty.String),
+	})
+
+	assert.Equal(t, "secret", GetWriteOnlyString(rawConfig, "password_wo"))
+	assert.Equal(t, "", GetWriteOnlyString(rawConfig, "payload_wo"))
+	assert.Equal(t, "", GetWriteOnlyString(rawConfig, "apikey_wo"))
+	assert.Equal(t, "", GetWriteOnlyString(rawConfig, "wo_version"))
+	assert.Equal(t, "", GetWriteOnlyString(rawConfig, "unrelated_wo"))
+	assert.Equal(t, "", GetWriteOnlyString(rawConfig, "missing"))
+	assert.Equal(t, "", GetWriteOnlyString(cty.NullVal(rawConfig.Type()), "password_wo"))
+	assert.Equal(t, "", GetWriteOnlyString(cty.DynamicVal, "password_wo"))
+}
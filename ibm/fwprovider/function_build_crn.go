@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &buildCRNFunction{}
+
+type buildCRNFunction struct{}
+
+// NewBuildCRNFunction returns the build_crn function.
+func NewBuildCRNFunction() function.Function {
+	return &buildCRNFunction{}
+}
+
+func (f *buildCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_crn"
+}
+
+func (f *buildCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an IBM Cloud CRN from its segments",
+		Description: "Returns the Cloud Resource Name composed of the segments of a map with the keys of the object returned by parse_crn. " +
+			"The scheme defaults to crn, the version to v1, the cname to bluemix and the ctype to public. " +
+			"account_id sets the scope to the account, and location, which is derived from the cname and the region, is ignored.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "segments",
+				Description: "The segments of the CRN, such as service_name, region, account_id, service_instance, resource_type and resource.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var segments map[string]string
+	resp.Error = req.Arguments.Get(ctx, &segments)
+	if resp.Error != nil {
+		return
+	}
+	crn, err := buildCRN(segments)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, crn)
+}
+
+func buildCRN(segments map[string]string) (string, error) {
+	var unsupported []string
+	for key := range segments {
+		if _, ok := crnAttributeTypes[key]; !ok {
+			unsupported = append(unsupported, key)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return "", fmt.Errorf("unsupported CRN segments %s", strings.Join(unsupported, ", "))
+	}
+
+	crn := flex.CRN{
+		Scheme:          valueOrDefault(segments["scheme"], "crn"),
+		Version:         valueOrDefault(segments["version"], "v1"),
+		CName:           valueOrDefault(segments["cname"], "bluemix"),
+		CType:           valueOrDefault(segments["ctype"], "public"),
+		ServiceName:     segments["service_name"],
+		Region:          segments["region"],
+		ScopeType:       segments["scope_type"],
+		Scope:           segments["scope"],
+		ServiceInstance: segments["service_instance"],
+		ResourceType:    segments["resource_type"],
+		Resource:        segments["resource"],
+	}
+	if accountID := segments["account_id"]; accountID != "" {
+		if crn.Scope == "" && crn.ScopeType == "" {
+			crn.ScopeType, crn.Scope = "a", accountID
+		} else if crn.AccountID() != accountID {
+			return "", fmt.Errorf("the account_id %q does not match the scope %q", accountID, strings.TrimPrefix(crn.ScopeType+"/"+crn.Scope, "/"))
+		}
+	}
+
+	// Parse rejects the segments with a separator, which would shift the others
+	s := crn.String()
+	if _, err := parseCRN(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
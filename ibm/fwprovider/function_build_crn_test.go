@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"testing"
+)
+
+func TestBuildCRN(t *testing.T) {
+	for _, c := range []struct {
+		segments map[string]string
+		expected string
+		err      bool
+	}{
+		{
+			segments: map[string]string{"service_name": "kms", "region": "us-south", "account_id": "0123456789abcdef", "service_instance": "instance"},
+			expected: "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:instance::",
+		},
+		{
+			segments: map[string]string{"service_name": "globalcatalog", "scope": "global"},
+			expected: "crn:v1:bluemix:public:globalcatalog::global:::",
+		},
+		{
+			// the object returned by parse_crn
+			segments: map[string]string{
+				"scheme": "crn", "version": "v1", "cname": "bluemix", "ctype": "public", "service_name": "kms",
+				"region": "us-south", "location": "us-south", "scope_type": "a", "scope": "0123456789abcdef",
+				"account_id": "0123456789abcdef", "service_instance": "instance", "resource_type": "key", "resource": "8a0b",
+			},
+			expected: "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:instance:key:8a0b",
+		},
+		{
+			segments: map[string]string{"service_name": "kms", "scope_type": "s", "scope": "space", "account_id": "0123456789abcdef"},
+			err:      true,
+		},
+		{
+			segments: map[string]string{"service": "kms"},
+			err:      true,
+		},
+		{
+			segments: map[string]string{"service_name": "kms", "resource": "a:b"},
+			err:      true,
+		},
+	} {
+		crn, err := buildCRN(c.segments)
+		if c.err {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %s", c.segments, crn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: err: %s", c.segments, err)
+		} else if crn != c.expected {
+			t.Errorf("%v: expected %s, got %s", c.segments, c.expected, crn)
+		}
+	}
+}
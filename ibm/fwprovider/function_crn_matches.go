@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &crnMatchesFunction{}
+
+type crnMatchesFunction struct{}
+
+// NewCRNMatchesFunction returns the crn_matches function.
+func NewCRNMatchesFunction() function.Function {
+	return &crnMatchesFunction{}
+}
+
+func (f *crnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_matches"
+}
+
+func (f *crnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an IBM Cloud CRN matches a pattern",
+		Description: "Returns true when every segment of the CRN equals the segment of the pattern. " +
+			"The empty and * segments of the pattern match any value, and a type/* segment, such as the a/* scope, any value of the type.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to check.",
+				Validators:  []function.StringParameterValidator{crnValidator{}},
+			},
+			function.StringParameter{
+				Name:        "pattern",
+				Description: "The CRN pattern, such as crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef::: for all the Key Protect resources of an account in us-south.",
+				Validators:  []function.StringParameterValidator{crnValidator{pattern: true}},
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *crnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var crn, pattern string
+	resp.Error = req.Arguments.Get(ctx, &crn, &pattern)
+	if resp.Error != nil {
+		return
+	}
+	if _, err := parseCRN(crn); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if _, err := parseCRNPattern(pattern); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, crnMatches(crn, pattern))
+}
+
+// crnMatches compares the CRN and the pattern segment by segment, both being
+// well-formed CRNs.
+func crnMatches(crn, pattern string) bool {
+	segments, patternSegments := strings.Split(crn, crnSeparator), strings.Split(pattern, crnSeparator)
+	if len(segments) != len(patternSegments) {
+		return false
+	}
+	for n, patternSegment := range patternSegments {
+		if !crnSegmentMatches(segments[n], patternSegment) {
+			return false
+		}
+	}
+	return true
+}
+
+func crnSegmentMatches(segment, pattern string) bool {
+	if pattern == "" || pattern == "*" || pattern == segment {
+		return true
+	}
+	// type/* matches any value of the type, such as the a/* scope
+	if scopeType, value, ok := strings.Cut(pattern, "/"); ok && value == "*" {
+		return strings.HasPrefix(segment, scopeType+"/")
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"testing"
+)
+
+func TestCRNMatches(t *testing.T) {
+	crn := "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:instance:key:8a0b"
+	for pattern, expected := range map[string]bool{
+		crn: true,
+		"crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:::":     true,
+		"crn:v1:bluemix:public:kms:*:a/*:*:key:":                       true,
+		"crn:v1:bluemix:public::::::":                                  true,
+		"crn:v1:bluemix:public:kms:eu-de:a/0123456789abcdef:::":        false,
+		"crn:v1:bluemix:public:kms:us-south:a/fedcba9876543210:::":     false,
+		"crn:v1:bluemix:public:kms:us-south:s/*:::":                    false,
+		"crn:v1:bluemix:public:secrets-manager:us-south::instance::":   false,
+		"crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef::key:x": false,
+	} {
+		if matches := crnMatches(crn, pattern); matches != expected {
+			t.Errorf("%s: expected %t, got %t", pattern, expected, matches)
+		}
+	}
+}
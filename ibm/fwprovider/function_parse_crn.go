@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseCRNFunction{}
+
+// crnAttributeTypes are the attributes of the object returned by parse_crn,
+// which are also the keys accepted by build_crn.
+var crnAttributeTypes = map[string]attr.Type{
+	"scheme":           types.StringType,
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"location":         types.StringType,
+	"scope_type":       types.StringType,
+	"scope":            types.StringType,
+	"account_id":       types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+type crnModel struct {
+	Scheme          string `tfsdk:"scheme"`
+	Version         string `tfsdk:"version"`
+	CName           string `tfsdk:"cname"`
+	CType           string `tfsdk:"ctype"`
+	ServiceName     string `tfsdk:"service_name"`
+	Region          string `tfsdk:"region"`
+	Location        string `tfsdk:"location"`
+	ScopeType       string `tfsdk:"scope_type"`
+	Scope           string `tfsdk:"scope"`
+	AccountID       string `tfsdk:"account_id"`
+	ServiceInstance string `tfsdk:"service_instance"`
+	ResourceType    string `tfsdk:"resource_type"`
+	Resource        string `tfsdk:"resource"`
+}
+
+func newCRNModel(crn flex.CRN) crnModel {
+	return crnModel{
+		Scheme:          crn.Scheme,
+		Version:         crn.Version,
+		CName:           crn.CName,
+		CType:           crn.CType,
+		ServiceName:     crn.ServiceName,
+		Region:          crn.Region,
+		Location:        crn.Location(),
+		ScopeType:       crn.ScopeType,
+		Scope:           crn.Scope,
+		AccountID:       crn.AccountID(),
+		ServiceInstance: crn.ServiceInstance,
+		ResourceType:    crn.ResourceType,
+		Resource:        crn.Resource,
+	}
+}
+
+// crnSeparator separates the segments of a CRN.
+const crnSeparator = ":"
+
+// crnValidator rejects the arguments that are not well-formed CRNs, see
+// flex.Parse, or CRN patterns.
+type crnValidator struct {
+	pattern bool
+}
+
+func (v crnValidator) ValidateParameterString(ctx context.Context, req function.StringParameterValidatorRequest, resp *function.StringParameterValidatorResponse) {
+	if req.Value.IsNull() || req.Value.IsUnknown() {
+		return
+	}
+	parse := parseCRN
+	if v.pattern {
+		parse = parseCRNPattern
+	}
+	if _, err := parse(req.Value.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(req.ArgumentPosition, err.Error())
+	}
+}
+
+// parseCRN parses a CRN with flex.Parse, which accepts an empty string.
+func parseCRN(s string) (flex.CRN, error) {
+	if s == "" {
+		return flex.CRN{}, fmt.Errorf("%s: the CRN is empty", flex.ErrMalformedCRN)
+	}
+	crn, err := flex.Parse(s)
+	if err != nil {
+		return crn, fmt.Errorf("%s %q, expected crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource", err, s)
+	}
+	return crn, nil
+}
+
+// parseCRNPattern parses a CRN whose segments may be the * wildcard.
+func parseCRNPattern(s string) (flex.CRN, error) {
+	segments := strings.Split(s, crnSeparator)
+	for n, segment := range segments {
+		if n > 0 && segment == "*" {
+			segments[n] = ""
+		}
+	}
+	return parseCRN(strings.Join(segments, crnSeparator))
+}
+
+type parseCRNFunction struct{}
+
+// NewParseCRNFunction returns the parse_crn function.
+func NewParseCRNFunction() function.Function {
+	return &parseCRNFunction{}
+}
+
+func (f *parseCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_crn"
+}
+
+func (f *parseCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an IBM Cloud CRN into its segments",
+		Description: "Returns the segments of a Cloud Resource Name, with the location of the resource and the ID of its account when the CRN is scoped to an account.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse.",
+				Validators:  []function.StringParameterValidator{crnValidator{}},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: crnAttributeTypes,
+		},
+	}
+}
+
+func (f *parseCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+	resp.Error = req.Arguments.Get(ctx, &s)
+	if resp.Error != nil {
+		return
+	}
+	crn, err := parseCRN(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, newCRNModel(crn))
+}
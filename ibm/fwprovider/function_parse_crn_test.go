@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestParseCRNFunction(t *testing.T) {
+	ctx := context.Background()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:2f3c4c6e-0000-4000-8000-000000000000:key:8a0b"),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(crnAttributeTypes))}
+	NewParseCRNFunction().Run(ctx, req, resp)
+	if resp.Error != nil {
+		t.Fatalf("err: %s", resp.Error)
+	}
+
+	var crn crnModel
+	if diags := resp.Result.Value().(types.Object).As(ctx, &crn, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	expected := crnModel{
+		Scheme:          "crn",
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     "kms",
+		Region:          "us-south",
+		Location:        "us-south",
+		ScopeType:       "a",
+		Scope:           "0123456789abcdef",
+		AccountID:       "0123456789abcdef",
+		ServiceInstance: "2f3c4c6e-0000-4000-8000-000000000000",
+		ResourceType:    "key",
+		Resource:        "8a0b",
+	}
+	if crn != expected {
+		t.Errorf("expected %+v, got %+v", expected, crn)
+	}
+}
+
+func TestCRNValidator(t *testing.T) {
+	for _, c := range []struct {
+		value   string
+		pattern bool
+		valid   bool
+	}{
+		{value: "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:instance::", valid: true},
+		{value: "crn:v1:bluemix:public:globalcatalog::global:::", valid: true},
+		{value: "", valid: false},
+		{value: "crn:v1:bluemix:public:kms:us-south", valid: false},
+		{value: "arn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:instance::", valid: false},
+		{value: "crn:v1:bluemix:public:kms:us-south:a/b/c:instance::", valid: false},
+		{value: "crn:v1:bluemix:public:kms:*:*:::", valid: false},
+		{value: "crn:v1:bluemix:public:kms:*:*:::", pattern: true, valid: true},
+		{value: "crn:*:*:*:kms:*:a/*:*:*:*", pattern: true, valid: true},
+	} {
+		resp := &function.StringParameterValidatorResponse{}
+		crnValidator{pattern: c.pattern}.ValidateParameterString(context.Background(), function.StringParameterValidatorRequest{
+			Value: types.StringValue(c.value),
+		}, resp)
+		if valid := resp.Error == nil; valid != c.valid {
+			t.Errorf("%q: expected valid to be %t, got %s", c.value, c.valid, resp.Error)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package fwprovider is the terraform-plugin-framework provider, served with
+// the SDKv2 provider through a mux server. It hosts the capabilities the SDKv2
+// does not support, such as the provider functions and the ephemeral
+// resources, and shares the client session of the SDKv2 provider.
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+type frameworkProvider struct {
+	// primary is the SDKv2 provider muxed with this one, which configures the client session
+	primary *sdkschema.Provider
+}
+
+// New returns the framework provider muxed with the SDKv2 provider primary.
+func New(primary *sdkschema.Provider) provider.Provider {
+	return &frameworkProvider{primary: primary}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ibm"
+	resp.Version = version.Version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerSchema(p.primary.Schema)
+}
+
+// Configure shares the client session of the SDKv2 provider with the
+// resources and data sources of the framework provider. The mux server
+// configures the SDKv2 provider first.
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	session, ok := p.primary.Meta().(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The IBM Cloud client session was not configured by the SDKv2 provider. This is a bug in the provider, please report it.",
+		)
+		return
+	}
+	resp.DataSourceData = session
+	resp.ResourceData = session
+	resp.EphemeralResourceData = session
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iamidentity.NewIAMAuthTokenEphemeralResource,
+		resourcecontroller.NewResourceKeyEphemeralResource,
+		secretsmanager.NewSecretEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBuildCRNFunction,
+		NewCRNMatchesFunction,
+		NewParseCRNFunction,
+	}
+}
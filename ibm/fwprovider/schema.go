@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// providerSchema converts the schema of the SDKv2 provider block, which the
+// mux server requires the framework provider to declare as well. The defaults
+// and validations of the arguments are left to the SDKv2 provider.
+func providerSchema(fields map[string]*sdkschema.Schema) schema.Schema {
+	attributes, blocks := convertFields(fields)
+	return schema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+func convertFields(fields map[string]*sdkschema.Schema) (map[string]schema.Attribute, map[string]schema.Block) {
+	attributes := map[string]schema.Attribute{}
+	blocks := map[string]schema.Block{}
+	for name, field := range fields {
+		if elem, ok := field.Elem.(*sdkschema.Resource); ok {
+			blocks[name] = convertBlock(field, elem)
+		} else {
+			attributes[name] = convertAttribute(field)
+		}
+	}
+	return attributes, blocks
+}
+
+func convertBlock(field *sdkschema.Schema, elem *sdkschema.Resource) schema.Block {
+	attributes, blocks := convertFields(elem.Schema)
+	nested := schema.NestedBlockObject{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+	if field.Type == sdkschema.TypeSet {
+		return schema.SetNestedBlock{
+			NestedObject:       nested,
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+		}
+	}
+	return schema.ListNestedBlock{
+		NestedObject:       nested,
+		Description:        field.Description,
+		DeprecationMessage: field.Deprecated,
+	}
+}
+
+func convertAttribute(field *sdkschema.Schema) schema.Attribute {
+	optional := !field.Required
+	switch field.Type {
+	case sdkschema.TypeBool:
+		return schema.BoolAttribute{Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	case sdkschema.TypeInt:
+		return schema.Int64Attribute{Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	case sdkschema.TypeFloat:
+		return schema.Float64Attribute{Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	case sdkschema.TypeList:
+		return schema.ListAttribute{ElementType: elementType(field.Elem), Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	case sdkschema.TypeSet:
+		return schema.SetAttribute{ElementType: elementType(field.Elem), Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	case sdkschema.TypeMap:
+		return schema.MapAttribute{ElementType: elementType(field.Elem), Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	default:
+		return schema.StringAttribute{Required: field.Required, Optional: optional, Sensitive: field.Sensitive, Description: field.Description, DeprecationMessage: field.Deprecated}
+	}
+}
+
+// elementType returns the type of the elements of a list, set or map of
+// primitives, string when it is not set, as the SDKv2 does for maps.
+func elementType(elem interface{}) attr.Type {
+	field, ok := elem.(*sdkschema.Schema)
+	if !ok {
+		return types.StringType
+	}
+	switch field.Type {
+	case sdkschema.TypeBool:
+		return types.BoolType
+	case sdkschema.TypeInt:
+		return types.Int64Type
+	case sdkschema.TypeFloat:
+		return types.Float64Type
+	default:
+		return types.StringType
+	}
+}
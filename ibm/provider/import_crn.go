@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionName matches the names of the regions, such as us-south, as opposed
+// to the global location or the locations of the classic infrastructure, and
+// zoneSuffix the suffix of the zones of a region, such as -1 in us-south-1.
+var (
+	regionName = regexp.MustCompile(`^[a-z]{2}-[a-z]{2,5}$`)
+	zoneSuffix = regexp.MustCompile(`-[0-9]+$`)
+)
+
+// withCRNImporter accepts the CRN of a resource as its import ID, resolved to
+// the ID of the resource by flex.ImportIDFromCRN, so that any resource can be
+// imported with the CRN returned by Global Search. A regional resource is
+// imported from the region of its CRN, see withRegionImporter.
+func withCRNImporter(resourceName string, importer *schema.ResourceImporter, regional bool) *schema.ResourceImporter {
+	if importer == nil {
+		return nil
+	}
+	state := importer.StateContext
+	if state == nil && importer.State != nil {
+		state = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return importer.State(d, meta)
+		}
+	}
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if crn := d.Id(); flex.IsImportCRN(crn) {
+				id, err := flex.ImportIDFromCRN(resourceName, crn)
+				if err != nil {
+					return nil, err
+				}
+				if c, _ := flex.Parse(crn); regional {
+					if region, ok := crnRegion(c.Region); ok {
+						id += "@" + region
+					}
+				}
+				log.Printf("[INFO] Importing %s %s with the ID %s", resourceName, crn, id)
+				d.SetId(id)
+			}
+			if state == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+			return state(ctx, d, meta)
+		},
+	}
+}
+
+// crnRegion returns the region of the location of a CRN, the region of the
+// zone for the zonal resources, and false for the locations that are not in a
+// region.
+func crnRegion(location string) (string, bool) {
+	region := zoneSuffix.ReplaceAllString(location, "")
+	return region, regionName.MatchString(region)
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCRNRegion(t *testing.T) {
+	cases := []struct {
+		location, region string
+		ok               bool
+	}{
+		{"us-south", "us-south", true},
+		{"us-south-1", "us-south", true},
+		{"eu-de-3", "eu-de", true},
+		{"jp-tok", "jp-tok", true},
+		{"global", "global", false},
+		{"dal10", "dal10", false},
+		{"", "", false},
+	}
+	for _, tc := range cases {
+		region, ok := crnRegion(tc.location)
+		if ok != tc.ok || ok && region != tc.region {
+			t.Fatalf("%q: expected %q, %t, got %q, %t", tc.location, tc.region, tc.ok, region, ok)
+		}
+	}
+}
+
+func TestWithCRNImporter(t *testing.T) {
+	cases := []struct {
+		resourceType, id, expectedID string
+		regional                     bool
+	}{
+		{"ibm_is_vpc", "crn:v1:bluemix:public:is:eu-de:a/acc::vpc:r010-1111", "r010-1111@eu-de", true},
+		{"ibm_is_instance", "crn:v1:bluemix:public:is:us-south-1:a/acc::instance:0717-2222", "0717-2222@us-south", true},
+		{"ibm_is_volume", "crn:v1:bluemix:public:is:eu-de-3:a/acc::volume:r010-3333", "r010-3333@eu-de", true},
+		{"ibm_iam_access_group", "crn:v1:bluemix:public:iam-groups::a/acc::access-group:AccessGroupId-1", "AccessGroupId-1", false},
+		{"ibm_dns_zone", "crn:v1:bluemix:public:dns-svcs:global:a/acc:instance-1:zone:zone-1", "instance-1/zone-1", false},
+		{"ibm_is_vpc", "r010-1111", "r010-1111", true},
+	}
+	for _, tc := range cases {
+		importer := withCRNImporter(tc.resourceType, &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		}, tc.regional)
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId(tc.id)
+		if _, err := importer.StateContext(context.Background(), d, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.id, err)
+		}
+		if d.Id() != tc.expectedID {
+			t.Fatalf("%s: expected the ID %s, got %s", tc.id, tc.expectedID, d.Id())
+		}
+	}
+}
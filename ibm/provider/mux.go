@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/fwprovider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// ProviderAddress is the address of the provider in the Terraform registry.
+const ProviderAddress = "registry.terraform.io/IBM-Cloud/ibm"
+
+// ProtoV5ProviderServerFactory returns the factory of the provider server, a
+// mux of the SDKv2 provider and of the plugin framework provider. The SDKv2
+// provider comes first, so that it configures the client session the
+// framework provider shares, and rejects the replacement of the protected
+// resources, see withProtection.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	primary := Provider()
+	servers := []func() tfprotov5.ProviderServer{
+		withProtection(primary, primary.GRPCProvider),
+		providerserver.NewProtocol5(fwprovider.New(primary)),
+	}
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
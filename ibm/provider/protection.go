@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// checkProtection rejects the deletion of the resources protected by the
+// protect_resource_types and protect_tagged provider arguments, unless
+// override_protection is set. Their replacements are rejected on plan, see
+// withProtection.
+func checkProtection(resourceName, operationName string, d *schema.ResourceData, meta interface{}, isDataSource bool) diag.Diagnostics {
+	if isDataSource || operationName != "delete" {
+		return nil
+	}
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	protection := session.ProtectionConfig()
+	reason, protected := protection.Protects(resourceName, protectionTags(d.Get))
+	if !protected {
+		return nil
+	}
+	if protection.Override {
+		log.Printf("[WARN] Deleting the protected resource %s %s, override_protection is set: %s", resourceName, d.Id(), reason)
+		return nil
+	}
+	summary := fmt.Sprintf("The resource %s cannot be deleted: %s. Set override_protection to true, or the IBMCLOUD_OVERRIDE_PROTECTION environment variable, to delete it", d.Id(), reason)
+	return wrapError(flex.TerraformErrorf(conns.ErrProtected, summary, resourceName, operationName), resourceName, operationName, isDataSource)
+}
+
+// withProtection wraps the server of the SDKv2 provider p, to reject on plan
+// the replacement of the resources protected by the provider configuration.
+// The replacement is the one of the planned diff, so that the changes of the
+// ForceNew arguments of the nested blocks of any type, and the arguments set
+// ForceNew by the CustomizeDiff function of the resource, are all included.
+func withProtection(p *schema.Provider, server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return protectionServer{ProviderServer: server(), provider: p}
+	}
+}
+
+// protectionServer is the provider server returned by withProtection.
+type protectionServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+// PlanResourceChange implements the tfprotov5.ResourceServer interface.
+func (s protectionServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	replacedBy := forceNewChanges(resp.RequiresReplace)
+	if len(replacedBy) == 0 {
+		return resp, nil
+	}
+	for _, d := range checkReplacement(s.provider, req.TypeName, req.PriorState, replacedBy) {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+		})
+	}
+	return resp, nil
+}
+
+// checkReplacement rejects the replacement of the resource of type
+// resourceName with the prior state priorState, by the changes of the
+// arguments replacedBy, when the resource is protected.
+func checkReplacement(p *schema.Provider, resourceName string, priorState *tfprotov5.DynamicValue, replacedBy []string) diag.Diagnostics {
+	session, ok := p.Meta().(conns.ClientSession)
+	resource, found := p.ResourcesMap[resourceName]
+	if !ok || !found || priorState == nil {
+		return nil
+	}
+	// The protection of the resource is the one of its state, so that
+	// removing the protect_tagged tag takes an apply before the replacement
+	value, err := msgpack.Unmarshal(priorState.MsgPack, resource.CoreConfigSchema().ImpliedType())
+	if err != nil || value.IsNull() {
+		return wrapError(err, resourceName, "plan", false)
+	}
+	state, err := resource.ShimInstanceStateFromValue(value)
+	if err != nil {
+		return wrapError(err, resourceName, "plan", false)
+	}
+	d := resource.Data(state)
+
+	protection := session.ProtectionConfig()
+	reason, protected := protection.Protects(resourceName, protectionTags(d.Get))
+	if !protected {
+		return nil
+	}
+	if protection.Override {
+		log.Printf("[WARN] Replacing the protected resource %s %s, override_protection is set: %s", resourceName, d.Id(), reason)
+		return nil
+	}
+	summary := fmt.Sprintf("The resource %s cannot be replaced: %s, and the change of %s replaces the resource. Set override_protection to true, or the IBMCLOUD_OVERRIDE_PROTECTION environment variable, to replace it",
+		d.Id(), reason, strings.Join(replacedBy, ", "))
+	return wrapError(flex.TerraformErrorf(conns.ErrProtected, summary, resourceName, "plan"), resourceName, "plan", false)
+}
+
+// protectionTags returns the user tags of a resource, read with get from its
+// tags and tags_all attributes.
+func protectionTags(get func(string) interface{}) []string {
+	var tags []string
+	for _, key := range []string{"tags", flex.TagsAll} {
+		switch v := get(key).(type) {
+		case *schema.Set:
+			tags = append(tags, flex.ExpandStringList(v.List())...)
+		case []interface{}:
+			tags = append(tags, flex.ExpandStringList(v)...)
+		}
+	}
+	return tags
+}
+
+// forceNewChanges returns the arguments whose change replaces the resource,
+// from the RequiresReplace paths of its planned diff, such as rule.0.port.
+// The id attribute, added by the SDK to every replacement, is left out, as
+// are the elements of the sets, which have no index.
+func forceNewChanges(requiresReplace []*tftypes.AttributePath) []string {
+	var keys []string
+	for _, path := range requiresReplace {
+		var parts []string
+		for _, step := range path.Steps() {
+			switch step := step.(type) {
+			case tftypes.AttributeName:
+				parts = append(parts, string(step))
+			case tftypes.ElementKeyInt:
+				parts = append(parts, strconv.FormatInt(int64(step), 10))
+			case tftypes.ElementKeyString:
+				parts = append(parts, string(step))
+			}
+		}
+		if key := strings.Join(parts, "."); key != "" && key != "id" {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
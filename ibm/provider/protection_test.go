@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// protectionSession is a client session with the protection settings config.
+type protectionSession struct {
+	conns.ClientSession
+	config *conns.ProtectionConfig
+}
+
+func (s protectionSession) ProtectionConfig() *conns.ProtectionConfig {
+	return s.config
+}
+
+// protectionTestProvider returns a provider with the resource ibm_test_bucket,
+// configured with the protection settings config.
+func protectionTestProvider(config *conns.ProtectionConfig) *schema.Provider {
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"ibm_test_bucket": {
+				Schema: map[string]*schema.Schema{
+					"name":        {Type: schema.TypeString, Required: true, ForceNew: true},
+					"description": {Type: schema.TypeString, Optional: true},
+					"size":        {Type: schema.TypeInt, Optional: true},
+					"rule": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+							"port": {Type: schema.TypeInt, Required: true, ForceNew: true},
+						}},
+					},
+					"tags": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+				// The size of a bucket cannot be decreased
+				CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+					if o, n := d.GetChange("size"); n.(int) < o.(int) {
+						return d.ForceNew("size")
+					}
+					return nil
+				},
+			},
+		},
+	}
+	p.SetMeta(protectionSession{config: config})
+	return p
+}
+
+// testDynamicValue returns the value of the resource with the attributes
+// values, the other attributes null or empty. Nil values return the null
+// value of the resource.
+func testDynamicValue(t *testing.T, resource *schema.Resource, values map[string]cty.Value) *tfprotov5.DynamicValue {
+	ty := resource.CoreConfigSchema().ImpliedType()
+	value := cty.NullVal(ty)
+	if values != nil {
+		attributes := map[string]cty.Value{}
+		for name, attributeType := range ty.AttributeTypes() {
+			switch v, ok := values[name]; {
+			case ok:
+				attributes[name] = v
+			case attributeType.IsSetType():
+				attributes[name] = cty.SetValEmpty(attributeType.ElementType())
+			default:
+				attributes[name] = cty.NullVal(attributeType)
+			}
+		}
+		value = cty.ObjectVal(attributes)
+	}
+	b, err := msgpack.Marshal(value, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tfprotov5.DynamicValue{MsgPack: b}
+}
+
+func testBucket(name string, size int64, port int64, tags ...string) map[string]cty.Value {
+	values := map[string]cty.Value{
+		"name": cty.StringVal(name),
+		"size": cty.NumberIntVal(size),
+		"rule": cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"port": cty.NumberIntVal(port)})}),
+	}
+	if len(tags) > 0 {
+		var tagValues []cty.Value
+		for _, tag := range tags {
+			tagValues = append(tagValues, cty.StringVal(tag))
+		}
+		values["tags"] = cty.SetVal(tagValues)
+	}
+	return values
+}
+
+func TestWithProtection(t *testing.T) {
+	protectedType := &conns.ProtectionConfig{ResourceTypes: []string{"ibm_test_*"}}
+	protectedTag := &conns.ProtectionConfig{Tag: "protect:true"}
+	overridden := &conns.ProtectionConfig{ResourceTypes: []string{"ibm_test_bucket"}, Override: true}
+
+	cases := []struct {
+		name       string
+		config     *conns.ProtectionConfig
+		prior      map[string]cty.Value
+		planned    map[string]cty.Value
+		replacedBy string
+	}{
+		{"update", protectedType, testBucket("logs", 10, 80), testBucket("logs", 20, 80), ""},
+		{"ForceNew argument", protectedType, testBucket("logs", 10, 80), testBucket("audit", 10, 80), "name"},
+		{"ForceNew argument of a set block", protectedType, testBucket("logs", 10, 80), testBucket("logs", 10, 443), "rule"},
+		{"ForceNew by CustomizeDiff", protectedType, testBucket("logs", 10, 80), testBucket("logs", 5, 80), "size"},
+		{"creation", protectedType, nil, testBucket("logs", 10, 80), ""},
+		{"tagged", protectedTag, testBucket("logs", 10, 80, "env:prod", "protect:true"), testBucket("audit", 10, 80, "env:prod", "protect:true"), "name"},
+		{"tag of the state", protectedTag, testBucket("logs", 10, 80, "protect:true"), testBucket("audit", 10, 80), "name"},
+		{"not tagged", protectedTag, testBucket("logs", 10, 80, "env:prod"), testBucket("audit", 10, 80, "env:prod"), ""},
+		{"override_protection", overridden, testBucket("logs", 10, 80), testBucket("audit", 10, 80), ""},
+		{"no protection", nil, testBucket("logs", 10, 80), testBucket("audit", 10, 80), ""},
+	}
+	for _, tc := range cases {
+		p := protectionTestProvider(tc.config)
+		resource := p.ResourcesMap["ibm_test_bucket"]
+		prior := tc.prior
+		if prior != nil {
+			prior["id"] = cty.StringVal("bucket-1")
+		}
+		planned := map[string]cty.Value{}
+		for k, v := range tc.planned {
+			planned[k] = v
+		}
+		if prior != nil {
+			planned["id"] = prior["id"]
+		}
+
+		server := withProtection(p, p.GRPCProvider)()
+		resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "ibm_test_bucket",
+			PriorState:       testDynamicValue(t, resource, prior),
+			ProposedNewState: testDynamicValue(t, resource, planned),
+			Config:           testDynamicValue(t, resource, tc.planned),
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		var errs []string
+		for _, d := range resp.Diagnostics {
+			if d.Severity == tfprotov5.DiagnosticSeverityError {
+				errs = append(errs, d.Summary)
+			}
+		}
+		if tc.replacedBy == "" && len(errs) > 0 {
+			t.Fatalf("%s: unexpected errors: %v", tc.name, errs)
+		}
+		if tc.replacedBy != "" && (len(errs) != 1 || !strings.Contains(errs[0], "cannot be replaced") || !strings.Contains(errs[0], "the change of "+tc.replacedBy)) {
+			t.Fatalf("%s: expected the replacement by %s to be rejected, got %v", tc.name, tc.replacedBy, errs)
+		}
+	}
+}
+
+func TestCheckProtection(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+		"tags": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+	protected := protectionSession{config: &conns.ProtectionConfig{ResourceTypes: []string{"ibm_database"}, Tag: "protect:true"}}
+	overridden := protectionSession{config: &conns.ProtectionConfig{ResourceTypes: []string{"ibm_database"}, Override: true}}
+
+	cases := []struct {
+		name, resourceName, operationName string
+		tags                              []interface{}
+		meta                              interface{}
+		isDataSource, rejected            bool
+	}{
+		{"protected type", "ibm_database", "delete", nil, protected, false, true},
+		{"protected tag", "ibm_kms_key", "delete", []interface{}{"env:prod", "protect:true"}, protected, false, true},
+		{"not protected", "ibm_kms_key", "delete", []interface{}{"env:prod"}, protected, false, false},
+		{"update", "ibm_database", "update", nil, protected, false, false},
+		{"data source", "ibm_database", "delete", nil, protected, true, false},
+		{"override_protection", "ibm_database", "delete", nil, overridden, false, false},
+		{"unit tests", "ibm_database", "delete", nil, "meta", false, false},
+	}
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "db", "tags": tc.tags})
+		d.SetId("crn:v1:bluemix:public:databases-for-postgresql:us-south:a/acc:db-1::")
+		diags := checkProtection(tc.resourceName, tc.operationName, d, tc.meta, tc.isDataSource)
+		if diags.HasError() != tc.rejected {
+			t.Fatalf("%s: expected rejected %t, got %v", tc.name, tc.rejected, diags)
+		}
+		if tc.rejected && !strings.Contains(diags[0].Summary, "cannot be deleted") {
+			t.Fatalf("%s: expected the error of the deletion, got %s", tc.name, diags[0].Summary)
+		}
+	}
+}
+
+func TestForceNewChanges(t *testing.T) {
+	requiresReplace := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("id"),
+		tftypes.NewAttributePath().WithAttributeName("name"),
+		tftypes.NewAttributePath().WithAttributeName("boot_volume").WithElementKeyInt(0).WithAttributeName("size"),
+		tftypes.NewAttributePath().WithAttributeName("labels").WithElementKeyString("env"),
+		tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyValue(tftypes.NewValue(tftypes.String, "a")).WithAttributeName("port"),
+		tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyValue(tftypes.NewValue(tftypes.String, "b")).WithAttributeName("port"),
+	}
+	expected := []string{"boot_volume.0.size", "labels.env", "name", "rule.port"}
+	if keys := forceNewChanges(requiresReplace); !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("expected %v, got %v", expected, keys)
+	}
+
+	if keys := forceNewChanges([]*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}); len(keys) != 0 {
+		t.Fatalf("expected no replacement for a creation, got %v", keys)
+	}
+}
+
+func TestCheckReplacement(t *testing.T) {
+	p := protectionTestProvider(&conns.ProtectionConfig{ResourceTypes: []string{"ibm_test_bucket"}})
+	resource := p.ResourcesMap["ibm_test_bucket"]
+	prior := testBucket("logs", 10, 80)
+	prior["id"] = cty.StringVal("bucket-1")
+
+	diags := checkReplacement(p, "ibm_test_bucket", testDynamicValue(t, resource, prior), []string{"name", "size"})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "bucket-1") || !strings.Contains(diags[0].Summary, "the change of name, size") {
+		t.Fatalf("expected the replacement of bucket-1 to be rejected, got %v", diags)
+	}
+	if diags := checkReplacement(p, "ibm_test_bucket", testDynamicValue(t, resource, nil), []string{"name"}); diags != nil {
+		t.Fatalf("expected no error without prior state, got %v", diags)
+	}
+	if diags := checkReplacement(p, "ibm_test_other", testDynamicValue(t, resource, prior), []string{"name"}); diags != nil {
+		t.Fatalf("expected no error for another provider resource, got %v", diags)
+	}
+}
@@ -12,8 +12,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"go.opentelemetry.io/otel/codes"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -107,7 +110,8 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The IBM cloud Region (for example 'us-south').",
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south"),
+				// The us-south default is applied by providerConfigure, after the region of the profile
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, nil),
 			},
 			"zone": {
 				Type:        schema.TypeString,
@@ -206,6 +210,31 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"iam_profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the IAM Trusted Profile, instead of iam_profile_id, with compute_resource_auth container",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
+			},
+			"compute_resource_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues(conns.ComputeResourceAuthTypes),
+				Description:  "Authenticate as the IAM Trusted Profile with the identity of the compute resource the provider runs on: container or vpc_instance",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_COMPUTE_RESOURCE_AUTH", "IBMCLOUD_COMPUTE_RESOURCE_AUTH"}, nil),
+			},
+			"cr_token_filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The service account token file of the compute_resource_auth container, /var/run/secrets/tokens/vault-token or /var/run/secrets/tokens/sa-token by default",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILENAME", "IBMCLOUD_CR_TOKEN_FILENAME"}, nil),
+			},
+			"vpc_metadata_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of the metadata service of the compute_resource_auth vpc_instance, http://169.254.169.254 by default",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_VPC_METADATA_URL", "IBMCLOUD_VPC_METADATA_URL"}, nil),
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -223,7 +252,20 @@ func Provider() *schema.Provider {
 				Optional:     true,
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"}),
 				Description:  "Visibility of the provider if it is private or public.",
-				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
+				// The public default is applied by providerConfigure, after the visibility of the profile
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the profile of the shared config file that the credentials, region, resource group and visibility not set in the provider block or the environment are read from",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PROFILE", "IBMCLOUD_PROFILE"}, nil),
+			},
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the shared config file of the profiles, ~/.ibmcloud/terraform/config.json by default",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_SHARED_CONFIG_FILE", "IBMCLOUD_SHARED_CONFIG_FILE"}, nil),
 			},
 			"private_endpoint_type": {
 				Type:         schema.TypeString,
@@ -238,6 +280,122 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Custom service endpoints. They take precedence over the IBMCLOUD_* environment variables and the endpoints file, and only apply to this provider configuration",
+				Elem:        endpointsSchema(),
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy of the API calls. The number of retries is set by max_retries",
+				Elem:        retrySchema(),
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Client side rate limits of the API calls, applied to each service separately",
+				Elem:        rateLimitSchema(),
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Reject every create, update and delete, and every API request that may change a resource. Default is false",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_READ_ONLY", "IBMCLOUD_READ_ONLY"}, false),
+			},
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the file that a JSON lines record of every API call that may change a resource is appended to",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_AUDIT_LOG_PATH", "IBMCLOUD_AUDIT_LOG_PATH"}, nil),
+			},
+			"protect_resource_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Resource types, such as ibm_database, that can be neither deleted nor replaced unless override_protection is set. A type ending with * matches the types starting with its prefix",
+			},
+			"protect_tagged": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User tag, such as protect:true, of the resources that can be neither deleted nor replaced unless override_protection is set",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PROTECT_TAGGED", "IBMCLOUD_PROTECT_TAGGED"}, nil),
+			},
+			"override_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow the deletion and the replacement of the resources protected by protect_resource_types and protect_tagged. Default is false",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_OVERRIDE_PROTECTION", "IBMCLOUD_OVERRIDE_PROTECTION"}, false),
+			},
+			"max_idle_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of idle (keep-alive) connections kept open across all IBM Cloud API hosts. Default is 100",
+			},
+			"max_idle_connections_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of idle (keep-alive) connections kept open per IBM Cloud API host. Default is 20",
+			},
+			"idle_connection_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Time, in seconds, an idle connection is kept open before it is closed. Default is 90",
+			},
+			"tls_handshake_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Time, in seconds, to wait for a TLS handshake with an IBM Cloud API host. Default is 10",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a PEM encoded file with the certificate authorities trusted, in addition to the system ones, when connecting to IBM Cloud APIs",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CA_BUNDLE_FILE", "IBMCLOUD_CA_BUNDLE_FILE"}, nil),
+			},
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the proxy used for HTTPS requests to IBM Cloud APIs. Overrides the HTTPS_PROXY environment variable",
+			},
+			"default_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags attached to every resource that supports user tags, as key and value pairs. A tag with an empty value is attached as the bare key.",
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are managed outside of Terraform and are neither read nor removed by the resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag keys to ignore.",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag key prefixes to ignore.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -875,6 +1033,7 @@ func Provider() *schema.Provider {
 			// Added for Resource Tag
 			"ibm_resource_tag":   globaltagging.DataSourceIBMResourceTag(),
 			"ibm_iam_access_tag": globaltagging.DataSourceIBMIamAccessTag(),
+			"ibm_resources":      globaltagging.DataSourceIBMResources(),
 
 			// Atracker
 			"ibm_atracker_targets": atracker.DataSourceIBMAtrackerTargets(),
@@ -1724,7 +1883,7 @@ func Provider() *schema.Provider {
 			"ibm_logs_router_tenant": logsrouting.ResourceIBMLogsRouterTenant(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 
 	wrappedProvider := wrapProvider(provider)
@@ -1743,29 +1902,73 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 		wrappedDataSourcesMap[key] = wrapDataSource(key, value)
 	}
 
+	// Mask the values of the Sensitive fields wherever they are logged
+	conns.RegisterSensitiveFields(sensitiveFieldNames(provider.Schema)...)
+	for _, resources := range []map[string]*schema.Resource{wrappedResourcesMap, wrappedDataSourcesMap} {
+		for _, resource := range resources {
+			conns.RegisterSensitiveFields(sensitiveFieldNames(resource.Schema)...)
+		}
+	}
+
 	return schema.Provider{
-		Schema:         provider.Schema,
-		DataSourcesMap: wrappedDataSourcesMap,
-		ResourcesMap:   wrappedResourcesMap,
-		ConfigureFunc:  provider.ConfigureFunc,
+		Schema:               provider.Schema,
+		DataSourcesMap:       wrappedDataSourcesMap,
+		ResourcesMap:         wrappedResourcesMap,
+		ConfigureContextFunc: provider.ConfigureContextFunc,
+	}
+}
+
+// sensitiveFieldNames returns the names of the fields of a schema, nested
+// blocks included, that are marked Sensitive.
+func sensitiveFieldNames(fields map[string]*schema.Schema) []string {
+	var names []string
+	for name, field := range fields {
+		if field.Sensitive {
+			names = append(names, name)
+		}
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			names = append(names, sensitiveFieldNames(elem.Schema)...)
+		}
 	}
+	return names
 }
 
 func wrapResource(name string, resource *schema.Resource) *schema.Resource {
+	if flex.SupportsDefaultTags(resource.Schema) {
+		resource.Schema[flex.TagsAll] = flex.TagsAllSchema()
+		resource.CustomizeDiff = withDefaultTags(resource.CustomizeDiff)
+	}
+	regional := supportsRegion(name, resource)
+	if regional {
+		resource.Schema["region"] = regionSchema(false)
+		resource.Importer = withRegionImporter(resource.Importer)
+	}
+	resource.Importer = withCRNImporter(name, resource.Importer, regional)
+	wrap := func(
+		operationName string,
+		function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+		fallback func(*schema.ResourceData, interface{}) error,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if regional {
+			return withRegion(name, operationName, wrapFunction(name, operationName, function, fallback, false), false)
+		}
+		return wrapFunction(name, operationName, function, fallback, false)
+	}
+
 	return &schema.Resource{
 		Schema:               resource.Schema,
 		SchemaVersion:        resource.SchemaVersion,
 		MigrateState:         resource.MigrateState,
 		StateUpgraders:       resource.StateUpgraders,
 		Exists:               resource.Exists,
-		CreateContext:        wrapFunction(name, "create", resource.CreateContext, resource.Create, false),
-		ReadContext:          wrapFunction(name, "read", resource.ReadContext, resource.Read, false),
-		UpdateContext:        wrapFunction(name, "update", resource.UpdateContext, resource.Update, false),
-		DeleteContext:        wrapFunction(name, "delete", resource.DeleteContext, resource.Delete, false),
-		CreateWithoutTimeout: wrapFunction(name, "create", resource.CreateWithoutTimeout, nil, false),
-		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
-		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
-		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
+		CreateContext:        wrap("create", resource.CreateContext, resource.Create),
+		ReadContext:          wrap("read", resource.ReadContext, resource.Read),
+		UpdateContext:        wrap("update", resource.UpdateContext, resource.Update),
+		DeleteContext:        wrap("delete", resource.DeleteContext, resource.Delete),
+		CreateWithoutTimeout: wrap("create", resource.CreateWithoutTimeout, nil),
+		ReadWithoutTimeout:   wrap("read", resource.ReadWithoutTimeout, nil),
+		UpdateWithoutTimeout: wrap("update", resource.UpdateWithoutTimeout, nil),
+		DeleteWithoutTimeout: wrap("delete", resource.DeleteWithoutTimeout, nil),
 		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
 		Importer:             resource.Importer,
 		DeprecationMessage:   resource.DeprecationMessage,
@@ -1776,14 +1979,28 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 }
 
 func wrapDataSource(name string, resource *schema.Resource) *schema.Resource {
+	regional := supportsRegion(name, resource)
+	if regional {
+		resource.Schema["region"] = regionSchema(true)
+	}
+	wrap := func(
+		function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+		fallback func(*schema.ResourceData, interface{}) error,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if regional {
+			return withRegion(name, "read", wrapFunction(name, "read", function, fallback, true), true)
+		}
+		return wrapFunction(name, "read", function, fallback, true)
+	}
+
 	return &schema.Resource{
 		Schema:             resource.Schema,
 		SchemaVersion:      resource.SchemaVersion,
 		MigrateState:       resource.MigrateState,
 		StateUpgraders:     resource.StateUpgraders,
 		Exists:             resource.Exists,
-		ReadContext:        wrapFunction(name, "read", resource.ReadContext, resource.Read, true),
-		ReadWithoutTimeout: wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, true),
+		ReadContext:        wrap(resource.ReadContext, resource.Read),
+		ReadWithoutTimeout: wrap(resource.ReadWithoutTimeout, nil),
 		Importer:           resource.Importer,
 		DeprecationMessage: resource.DeprecationMessage,
 		Timeouts:           resource.Timeouts,
@@ -1799,7 +2016,14 @@ func wrapFunction(
 	isDataSource bool,
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
 	if function != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return traceFunction(resourceName, operationName, isDataSource, func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if diags := checkReadOnly(resourceName, operationName, meta, isDataSource); diags != nil {
+				return diags
+			}
+			if diags := checkProtection(resourceName, operationName, schema, meta, isDataSource); diags != nil {
+				return diags
+			}
+			context = withOperation(context, resourceName, operationName, isDataSource)
 
 			// only allow deletion if the resource is not marked as protected
 			if operationName == "delete" && schema.Get("deletion_protection") != nil {
@@ -1821,16 +2045,68 @@ func wrapFunction(
 			}
 
 			return function(context, schema, meta)
-		}
+		})
 	} else if fallback != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return traceFunction(resourceName, operationName, isDataSource, func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if diags := checkReadOnly(resourceName, operationName, meta, isDataSource); diags != nil {
+				return diags
+			}
+			if diags := checkProtection(resourceName, operationName, schema, meta, isDataSource); diags != nil {
+				return diags
+			}
 			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
-		}
+		})
 	}
 
 	return nil
 }
 
+// traceFunction records a span for every call of a resource or data source
+// function. The spans of the API calls made with the context of the call are
+// children of it.
+func traceFunction(
+	resourceName, operationName string,
+	isDataSource bool,
+	function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := conns.StartOperationSpan(ctx, resourceName, operationName, isDataSource)
+		defer span.End()
+
+		diags := function(ctx, d, meta)
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				span.SetStatus(codes.Error, diagnostic.Summary)
+				break
+			}
+		}
+		return diags
+	}
+}
+
+// withOperation attributes the API calls made with ctx to the operation, for
+// the audit log.
+func withOperation(ctx context.Context, resourceName, operationName string, isDataSource bool) context.Context {
+	if isDataSource {
+		resourceName = fmt.Sprintf("(Data) %s", resourceName)
+	}
+	return conns.WithOperation(ctx, resourceName, operationName)
+}
+
+// checkReadOnly rejects the create, update and delete operations when the
+// provider is configured with read_only = true. The requests of the other
+// operations are checked by the transport of the service clients.
+func checkReadOnly(resourceName, operationName string, meta interface{}, isDataSource bool) diag.Diagnostics {
+	if isDataSource || (operationName != "create" && operationName != "update" && operationName != "delete") {
+		return nil
+	}
+	if session, ok := meta.(conns.ClientSession); !ok || !session.ReadOnly() {
+		return nil
+	}
+	summary := fmt.Sprintf("The %s operation is not allowed: set read_only to false to change resources", operationName)
+	return wrapError(flex.TerraformErrorf(conns.ErrReadOnly, summary, resourceName, operationName), resourceName, operationName, isDataSource)
+}
+
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
 	if err == nil {
 		return nil
@@ -1856,14 +2132,31 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	return append(
 		diags,
 		diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  tfError.Error(),
-			Detail:   tfError.GetConsoleMessage(),
+			Severity:      tfError.DiagSeverity(),
+			Summary:       tfError.Error(),
+			Detail:        tfError.GetConsoleMessage(),
+			AttributePath: tfError.AttributePath(),
 		},
 	)
 }
 
+// withDefaultTags runs the default tags handling after the CustomizeDiff of
+// the resource, so that "tags_all" reflects any change made to "tags" there.
+func withDefaultTags(function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if function == nil {
+		return flex.ResourceDefaultTagsCustomizeDiff
+	}
+
+	return func(c context.Context, rd *schema.ResourceDiff, i interface{}) error {
+		if err := function(c, rd, i); err != nil {
+			return err
+		}
+		return flex.ResourceDefaultTagsCustomizeDiff(c, rd, i)
+	}
+}
+
 func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	function = withCrossFieldRules(resourceName, function)
 	if function == nil {
 		return nil
 	}
@@ -1873,6 +2166,34 @@ func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) s
 	}
 }
 
+// withCrossFieldRules enforces the cross field rules of the validator of the
+// resource, see validate.CrossFieldRule, before function. The rules of the
+// resources are checked by TestProviderCrossFieldRules: the plans of a
+// resource with invalid rules fail with the error of the rules, which is
+// logged when the provider is created.
+func withCrossFieldRules(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	rules, err := validate.InvokeCrossFieldValidator(resourceName)
+	if err != nil {
+		log.Printf("%s", err)
+		return func(context.Context, *schema.ResourceDiff, interface{}) error {
+			return err
+		}
+	}
+	if rules == nil {
+		return function
+	}
+	if function == nil {
+		return rules
+	}
+
+	return func(c context.Context, rd *schema.ResourceDiff, i interface{}) error {
+		if err := rules(c, rd, i); err != nil {
+			return err
+		}
+		return function(c, rd, i)
+	}
+}
+
 func wrapDiffErrors(err error, resourceName string) error {
 	if err != nil {
 		// CustomizeDiff fields often use the customizediff.All() method, which concatenates the errors
@@ -2321,7 +2642,139 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+// endpointsSchema returns the schema of the provider endpoints block, with one
+// argument per entry of conns.ServiceEndpointKeys.
+func endpointsSchema() *schema.Resource {
+	endpoints := map[string]*schema.Schema{}
+	for name, key := range conns.ServiceEndpointKeys {
+		endpoints[name] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			Description:  fmt.Sprintf("Endpoint URL of the %s service, overrides %s", name, key),
+		}
+	}
+	return &schema.Resource{Schema: endpoints}
+}
+
+func retrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"base_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(conns.DefaultRetryBaseDelay / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Time, in seconds, to wait before the first retry. The delay doubles on every following retry",
+			},
+			"max_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(conns.RetryAPIDelay / time.Second),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum time, in seconds, to wait between two retries",
+			},
+			"jitter": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Randomize the delays between retries, so that concurrent requests do not retry in lockstep",
+			},
+			"retryable_status_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(400, 599)},
+				Description: "HTTP status codes retried in addition to 408, 429, 500, 502, 503, 504, 520 and 599",
+			},
+			"service": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Retry settings of a single service, overriding the ones above",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(conns.ServiceNames, false),
+							Description:  "Name of the service",
+						},
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The retry count of the API calls to the service",
+						},
+						"base_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Time, in seconds, to wait before the first retry of an API call to the service",
+						},
+						"max_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum time, in seconds, to wait between two retries of an API call to the service",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Resource {
+	limits := map[string]*schema.Schema{
+		"requests_per_second": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0.01),
+			Description:  "Rate, in requests per second, at which the API calls to a service are sent. Calls above the rate are queued",
+		},
+		"burst": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of API calls to a service that can be sent at once above requests_per_second. Defaults to requests_per_second",
+		},
+		"max_in_flight": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Maximum number of API calls to a service waiting for a response. Calls above the limit are queued",
+		},
+	}
+	service := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(conns.ServiceNames, false),
+			Description:  "Name of the service",
+		},
+	}
+	for k, v := range limits {
+		limit := *v
+		service[k] = &limit
+	}
+	limits["service"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Rate limits of a single service, overriding the ones above",
+		Elem:        &schema.Resource{Schema: service},
+	}
+	return &schema.Resource{Schema: limits}
+}
+
+func expandRateLimitConfig(limits map[string]interface{}) conns.RateLimitConfig {
+	return conns.RateLimitConfig{
+		RequestsPerSecond: limits["requests_per_second"].(float64),
+		Burst:             limits["burst"].(int),
+		MaxInFlight:       limits["max_in_flight"].(int),
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId string
@@ -2385,44 +2838,201 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	// Load the endpoints file once, up front, so that problems surface as diagnostics
+	_, warnings, err := conns.LoadEndpointsFile(conns.EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, file))
+	if err != nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid endpoints file",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("endpoints_file_path"),
+		})
+	}
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       warning,
+			AttributePath: cty.GetAttrPath("endpoints_file_path"),
+		})
+	}
+
+	defaultTags := map[string]string{}
+	for k, v := range d.Get("default_tags").(map[string]interface{}) {
+		defaultTags[k] = v.(string)
+	}
+	var ignoreTagKeys, ignoreTagKeyPrefixes []string
+	if v, ok := d.GetOk("ignore_tags"); ok && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		ignoreTagKeys = flex.ExpandStringList(ignoreTags["keys"].(*schema.Set).List())
+		ignoreTagKeyPrefixes = flex.ExpandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+
+	var caBundleFile, httpsProxy string
+	if f, ok := d.GetOk("ca_bundle_file"); ok {
+		caBundleFile = f.(string)
+	}
+	if p, ok := d.GetOk("https_proxy"); ok {
+		httpsProxy = p.(string)
+	}
+
+	endpoints := map[string]string{}
+	if v, ok := d.GetOk("endpoints"); ok && v.([]interface{})[0] != nil {
+		for name, url := range v.([]interface{})[0].(map[string]interface{}) {
+			if url.(string) != "" {
+				endpoints[conns.ServiceEndpointKeys[name]] = url.(string)
+			}
+		}
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
+
+	// Values missing from the provider block and the environment are read from
+	// the profile, if any, then defaulted. The credentials of the profile are
+	// used as a whole and only when no credentials are set otherwise, so that
+	// credentials of different sources are never mixed.
+	iamTrustedProfileName := d.Get("iam_profile_name").(string)
+	computeResourceAuth := d.Get("compute_resource_auth").(string)
+	crTokenFilename := d.Get("cr_token_filename").(string)
+	if name, ok := d.GetOk("profile"); ok {
+		profile, err := conns.LoadSharedConfigProfile(d.Get("shared_config_file").(string), name.(string))
+		if err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid profile",
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("profile"),
+			})
+		}
+		if bluemixAPIKey == "" && iamToken == "" && iamRefreshToken == "" && computeResourceAuth == "" && profile.HasCredentials() {
+			bluemixAPIKey = profile.IBMCloudAPIKey
+			computeResourceAuth = profile.ComputeResourceAuth
+			// The trusted profile and the token file of the provider block
+			// select the profile to assume, whatever the credentials
+			if iamTrustedProfileId == "" && iamTrustedProfileName == "" {
+				iamTrustedProfileId, iamTrustedProfileName = profile.IAMTrustedProfileID, profile.IAMTrustedProfileName
+			}
+			if crTokenFilename == "" {
+				crTokenFilename = profile.CRTokenFilename
+			}
+		}
+		if softlayerUsername == "" && softlayerAPIKey == "" {
+			softlayerUsername, softlayerAPIKey = profile.IAASClassicUsername, profile.IAASClassicAPIKey
+		}
+		if region == "" {
+			region = profile.Region
+		}
+		if resourceGrp == "" {
+			resourceGrp = profile.ResourceGroup
+		}
+		if visibility == "" {
+			visibility = profile.Visibility
+		}
+	}
+	if region == "" {
+		region = "us-south"
+	}
+	if visibility == "" {
+		visibility = "public"
+	}
 	retryCount := d.Get("max_retries").(int)
+
+	retryBaseDelay, retryMaxDelay, retryJitter := conns.DefaultRetryBaseDelay, conns.RetryAPIDelay, true
+	var retryStatusCodes []int
+	var serviceRetries map[string]conns.RetryConfig
+	if v, ok := d.GetOk("retry"); ok && v.([]interface{})[0] != nil {
+		retry := v.([]interface{})[0].(map[string]interface{})
+		retryBaseDelay = time.Duration(retry["base_delay"].(int)) * time.Second
+		retryMaxDelay = time.Duration(retry["max_delay"].(int)) * time.Second
+		retryJitter = retry["jitter"].(bool)
+		for _, code := range retry["retryable_status_codes"].(*schema.Set).List() {
+			retryStatusCodes = append(retryStatusCodes, code.(int))
+		}
+		serviceRetries = map[string]conns.RetryConfig{}
+		for _, s := range retry["service"].([]interface{}) {
+			service := s.(map[string]interface{})
+			serviceRetries[service["name"].(string)] = conns.RetryConfig{
+				MaxRetries: service["max_retries"].(int),
+				BaseDelay:  time.Duration(service["base_delay"].(int)) * time.Second,
+				MaxDelay:   time.Duration(service["max_delay"].(int)) * time.Second,
+			}
+		}
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
-		return nil, err
+		return nil, append(diags, diag.FromErr(err)...)
 	}
 	// Set environment variable to be used in DiffSupressFunction
 	if wskEnvVal.(string) == "" {
 		os.Setenv("FUNCTION_NAMESPACE", wskNameSpace)
 	}
 
+	var rateLimit conns.RateLimitConfig
+	var serviceRateLimits map[string]conns.RateLimitConfig
+	if v, ok := d.GetOk("rate_limit"); ok && v.([]interface{})[0] != nil {
+		limits := v.([]interface{})[0].(map[string]interface{})
+		rateLimit = expandRateLimitConfig(limits)
+		serviceRateLimits = map[string]conns.RateLimitConfig{}
+		for _, s := range limits["service"].([]interface{}) {
+			service := s.(map[string]interface{})
+			serviceRateLimits[service["name"].(string)] = expandRateLimitConfig(service)
+		}
+	}
+
 	config := conns.Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           conns.RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
-		PrivateEndpointType:  privateEndpointType,
-		EndpointsFile:        file,
-		IAMTrustedProfileID:  iamTrustedProfileId,
-	}
-
-	return config.ClientSession()
+		BluemixAPIKey:         bluemixAPIKey,
+		Region:                region,
+		ResourceGroup:         resourceGrp,
+		BluemixTimeout:        time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:      time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:     softlayerUsername,
+		SoftLayerAPIKey:       softlayerAPIKey,
+		RetryCount:            retryCount,
+		SoftLayerEndpointURL:  softlayerEndpointUrl,
+		RetryDelay:            retryMaxDelay,
+		RetryBaseDelay:        retryBaseDelay,
+		RetryJitter:           retryJitter,
+		RetryStatusCodes:      retryStatusCodes,
+		ServiceRetries:        serviceRetries,
+		RateLimit:             rateLimit,
+		ServiceRateLimits:     serviceRateLimits,
+		FunctionNameSpace:     wskNameSpace,
+		RiaasEndPoint:         riaasEndPoint,
+		IAMToken:              iamToken,
+		IAMRefreshToken:       iamRefreshToken,
+		Zone:                  zone,
+		Visibility:            visibility,
+		PrivateEndpointType:   privateEndpointType,
+		EndpointsFile:         file,
+		IAMTrustedProfileID:   iamTrustedProfileId,
+		IAMTrustedProfileName: iamTrustedProfileName,
+		ComputeResourceAuth:   computeResourceAuth,
+		CRTokenFilename:       crTokenFilename,
+		VPCMetadataURL:        d.Get("vpc_metadata_url").(string),
+		DefaultTags:           defaultTags,
+		IgnoreTagKeys:         ignoreTagKeys,
+		IgnoreTagKeyPrefixes:  ignoreTagKeyPrefixes,
+		Endpoints:             endpoints,
+		MaxIdleConns:          d.Get("max_idle_connections").(int),
+		MaxIdleConnsPerHost:   d.Get("max_idle_connections_per_host").(int),
+		IdleConnTimeout:       time.Duration(d.Get("idle_connection_timeout").(int)) * time.Second,
+		TLSHandshakeTimeout:   time.Duration(d.Get("tls_handshake_timeout").(int)) * time.Second,
+		CABundleFile:          caBundleFile,
+		HTTPSProxy:            httpsProxy,
+		ReadOnly:              d.Get("read_only").(bool),
+		AuditLogPath:          d.Get("audit_log_path").(string),
+		ProtectResourceTypes:  flex.ExpandStringList(d.Get("protect_resource_types").([]interface{})),
+		ProtectTag:            d.Get("protect_tagged").(string),
+		OverrideProtection:    d.Get("override_protection").(bool),
+	}
+
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return session, diags
 }
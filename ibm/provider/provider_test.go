@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// TestProviderCrossFieldRules checks the cross field rules of the validators
+// of the resources, which withCrossFieldRules would otherwise only reject at
+// plan time.
+func TestProviderCrossFieldRules(t *testing.T) {
+	for name := range Provider().ResourcesMap {
+		if _, err := validate.InvokeCrossFieldValidator(name); err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalResourcePrefixes and regionalResourceNames select the resources and
+// data sources that get a region argument overriding the region of the
+// provider, see withRegion.
+var (
+	regionalResourcePrefixes = []string{"ibm_is_", "ibm_cos_"}
+	regionalResourceNames    = []string{"ibm_resource_instance"}
+)
+
+// locationArguments are the arguments that set the location of a resource
+// of their own, such as the region_location and the cross_region_location of
+// ibm_cos_bucket. The resources with one of them keep it.
+var locationArguments = []string{
+	"region", "region_location", "cross_region_location", "single_site_location",
+	"satellite_location_id", "bucket_region", "bucket_location",
+}
+
+// regionImportID matches the <id>@<region> import IDs of the regional resources.
+var regionImportID = regexp.MustCompile(`^(.+)@([a-z]{2}-[a-z]{2,5})$`)
+
+// supportsRegion reports whether the region argument is added to the named
+// resource. Resources with a location argument of their own keep it, see
+// locationArguments.
+func supportsRegion(name string, resource *schema.Resource) bool {
+	for _, argument := range locationArguments {
+		if _, ok := resource.Schema[argument]; ok {
+			return false
+		}
+	}
+	for _, n := range regionalResourceNames {
+		if name == n {
+			return true
+		}
+	}
+	for _, prefix := range regionalResourcePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// regionSchema returns the region argument of the regional resources and data
+// sources. Changing the region of a resource replaces it.
+func regionSchema(isDataSource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    !isDataSource,
+		Description: "The region of the resource, the region of the provider by default.",
+	}
+}
+
+// withRegion calls function with the client session of the region of the
+// resource, and stores the region in the state so that the resource is read
+// from the same region whatever the region of the provider.
+func withRegion(
+	resourceName, operationName string,
+	function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	isDataSource bool,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if function == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		session, err := regionalSession(meta, d.Get("region").(string))
+		if err != nil {
+			return wrapError(err, resourceName, operationName, isDataSource)
+		}
+		if session == nil {
+			return function(ctx, d, meta)
+		}
+		diags := function(ctx, d, session)
+		if d.Id() != "" && !diags.HasError() {
+			if err := d.Set("region", session.Region()); err != nil {
+				return append(diags, wrapError(err, resourceName, operationName, isDataSource)...)
+			}
+		}
+		return diags
+	}
+}
+
+// withRegionImporter accepts <id>@<region> import IDs, to import a resource of
+// another region than the one of the provider, and runs the importer of the
+// resource with the client session of the region.
+func withRegionImporter(importer *schema.ResourceImporter) *schema.ResourceImporter {
+	if importer == nil {
+		return nil
+	}
+	state := importer.StateContext
+	if state == nil && importer.State != nil {
+		state = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return importer.State(d, meta)
+		}
+	}
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if m := regionImportID.FindStringSubmatch(d.Id()); m != nil {
+				d.SetId(m[1])
+				if err := d.Set("region", m[2]); err != nil {
+					return nil, err
+				}
+			}
+			session, err := regionalSession(meta, d.Get("region").(string))
+			if err != nil {
+				return nil, err
+			}
+			if session != nil {
+				meta = session
+			}
+			if state == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+			return state(ctx, d, meta)
+		},
+	}
+}
+
+// regionalSession returns the client session of region, nil when meta is
+// not a client session, e.g. in the unit tests of a resource.
+func regionalSession(meta interface{}, region string) (conns.ClientSession, error) {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil, nil
+	}
+	regional, err := session.ForRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error configuring the client session of region %s: %s", region, err)
+	}
+	return regional, nil
+}
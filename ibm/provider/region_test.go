@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionSession is the client session of a region, with the sessions of the
+// other regions returned by ForRegion, or its error.
+type regionSession struct {
+	conns.ClientSession
+	region string
+	err    error
+}
+
+func (s regionSession) Region() string {
+	return s.region
+}
+
+func (s regionSession) ForRegion(region string) (conns.ClientSession, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if region == "" || region == s.region {
+		return s, nil
+	}
+	return regionSession{region: region}, nil
+}
+
+func regionalResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name":   {Type: schema.TypeString, Optional: true},
+		"region": regionSchema(false),
+	}
+}
+
+func TestSupportsRegion(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		expected bool
+	}{
+		{"ibm_is_vpc", &schema.Resource{Schema: map[string]*schema.Schema{}}, true},
+		{"ibm_resource_instance", &schema.Resource{Schema: map[string]*schema.Schema{}}, true},
+		{"ibm_resource_instances", &schema.Resource{Schema: map[string]*schema.Schema{}}, false},
+		{"ibm_cos_backup_policy", &schema.Resource{Schema: map[string]*schema.Schema{}}, true},
+		{"ibm_cos_bucket", &schema.Resource{Schema: map[string]*schema.Schema{
+			"region_location": {Type: schema.TypeString, Optional: true},
+		}}, false},
+		{"ibm_cos_bucket_object", &schema.Resource{Schema: map[string]*schema.Schema{
+			"bucket_location": {Type: schema.TypeString, Required: true},
+		}}, false},
+		{"ibm_database", &schema.Resource{Schema: map[string]*schema.Schema{}}, false},
+		{"ibm_is_vpn_gateway", &schema.Resource{Schema: map[string]*schema.Schema{
+			"region": {Type: schema.TypeString, Required: true},
+		}}, false},
+	}
+	for _, tc := range cases {
+		if supportsRegion(tc.name, tc.resource) != tc.expected {
+			t.Fatalf("%s: expected supportsRegion %t", tc.name, tc.expected)
+		}
+	}
+}
+
+func TestWithRegion(t *testing.T) {
+	var got interface{}
+	function := func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		got = meta
+		d.SetId("r006-vpc")
+		return nil
+	}
+	read := withRegion("ibm_is_vpc", "read", function, false)
+
+	// The region of the resource selects the session
+	d := schema.TestResourceDataRaw(t, regionalResourceSchema(), map[string]interface{}{"region": "eu-de"})
+	if diags := read(context.Background(), d, regionSession{region: "us-south"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if s, ok := got.(regionSession); !ok || s.region != "eu-de" {
+		t.Fatalf("expected the session of eu-de, got %v", got)
+	}
+	if region := d.Get("region").(string); region != "eu-de" {
+		t.Fatalf("expected the region eu-de in the state, got %s", region)
+	}
+
+	// The region of the provider is stored by default
+	d = schema.TestResourceDataRaw(t, regionalResourceSchema(), map[string]interface{}{})
+	if diags := read(context.Background(), d, regionSession{region: "us-south"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if region := d.Get("region").(string); region != "us-south" {
+		t.Fatalf("expected the region of the provider in the state, got %s", region)
+	}
+
+	// Other metas, as in the unit tests of the resources, are passed as is
+	d = schema.TestResourceDataRaw(t, regionalResourceSchema(), map[string]interface{}{"region": "eu-de"})
+	if diags := read(context.Background(), d, "meta"); diags.HasError() || got != "meta" {
+		t.Fatalf("expected the meta passed as is, got %v, %v", got, diags)
+	}
+
+	// The errors of the session of the region are reported
+	got = nil
+	d = schema.TestResourceDataRaw(t, regionalResourceSchema(), map[string]interface{}{"region": "eu-de"})
+	diags := read(context.Background(), d, regionSession{region: "us-south", err: errors.New("no session")})
+	if !diags.HasError() || got != nil {
+		t.Fatalf("expected an error without calling the function, got %v", diags)
+	}
+
+	if withRegion("ibm_is_vpc", "read", nil, false) != nil {
+		t.Fatal("expected no function without function")
+	}
+}
+
+func TestWithRegionImporter(t *testing.T) {
+	var got interface{}
+	importer := withRegionImporter(&schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			got = meta
+			return schema.ImportStatePassthroughContext(ctx, d, meta)
+		},
+	})
+
+	cases := []struct {
+		id, expectedID, expectedRegion string
+	}{
+		{"r010-4b28a8b0@eu-de", "r010-4b28a8b0", "eu-de"},
+		{"r006-9c6a2b7e@us-south", "r006-9c6a2b7e", "us-south"},
+		{"r006-9c6a2b7e", "r006-9c6a2b7e", "us-south"},
+		// Only a region suffix is taken for a region
+		{"user@example.com", "user@example.com", "us-south"},
+		{"r006-9c6a2b7e@", "r006-9c6a2b7e@", "us-south"},
+	}
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, regionalResourceSchema(), map[string]interface{}{})
+		d.SetId(tc.id)
+		got = nil
+		if _, err := importer.StateContext(context.Background(), d, regionSession{region: "us-south"}); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.id, err)
+		}
+		if d.Id() != tc.expectedID {
+			t.Fatalf("%s: expected the ID %s, got %s", tc.id, tc.expectedID, d.Id())
+		}
+		if s, ok := got.(regionSession); !ok || s.region != tc.expectedRegion {
+			t.Fatalf("%s: expected the session of %s, got %v", tc.id, tc.expectedRegion, got)
+		}
+	}
+
+	if withRegionImporter(nil) != nil {
+		t.Fatal("expected no importer without importer")
+	}
+}
@@ -4,7 +4,9 @@
 package atracker_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -175,6 +177,80 @@ func testAccCheckIBMAtrackerTargetDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestResourceIBMAtrackerTargetMockCRUD(t *testing.T) {
+	mock := NewMockServer(t)
+	var target map[string]interface{}
+	mock.Expect("atracker", http.MethodPost, "/api/v2/targets").Times(1).RespondWith(func(r *http.Request, body []byte) (int, interface{}) {
+		json.Unmarshal(body, &target)
+		target["id"] = "mock-target-id"
+		target["crn"] = "crn:v1:bluemix:public:atracker:us-south:a/" + MockAccountID + ":mock-target-id::"
+		target["write_status"] = map[string]interface{}{"status": "success"}
+		target["created_at"] = "2025-01-01T00:00:00.000Z"
+		target["updated_at"] = "2025-01-01T00:00:00.000Z"
+		target["api_version"] = 2
+		return http.StatusCreated, target
+	})
+	mock.Expect("atracker", http.MethodGet, "/api/v2/targets/{id}").RespondWith(func(r *http.Request, body []byte) (int, interface{}) {
+		if target == nil {
+			return http.StatusNotFound, `{"status_code": 404, "errors": [{"code": "not_found", "message": "Target not found"}]}`
+		}
+		return http.StatusOK, target
+	})
+	mock.Expect("atracker", http.MethodPut, "/api/v2/targets/{id}").Times(1).RespondWith(func(r *http.Request, body []byte) (int, interface{}) {
+		var replace map[string]interface{}
+		json.Unmarshal(body, &replace)
+		target["name"] = replace["name"]
+		return http.StatusOK, target
+	})
+	mock.Expect("atracker", http.MethodDelete, "/api/v2/targets/{id}").Times(1).RespondWith(func(r *http.Request, body []byte) (int, interface{}) {
+		target = nil
+		return http.StatusNoContent, nil
+	})
+
+	config := func(name string) string {
+		return fmt.Sprintf(`
+			resource "ibm_atracker_target" "atracker_target_instance" {
+				name = "%s"
+				target_type = "cloud_logs"
+				cloudlogs_endpoint {
+					target_crn = "crn:v1:bluemix:public:logs:us-south:a/%s:33333333-3333-3333-3333-333333333333::"
+				}
+			}
+		`, name, MockAccountID)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: mock.ProviderFactories(),
+		CheckDestroy: func(*terraform.State) error {
+			if target != nil {
+				return fmt.Errorf("Activity Tracker Target still exists: %s", target["id"])
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-mock-target"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target_instance", "id", "mock-target-id"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target_instance", "name", "tf-mock-target"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target_instance", "write_status.0.status", "success"),
+				),
+			},
+			{
+				Config: config("tf-mock-target-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target_instance", "name", "tf-mock-target-updated"),
+				),
+			},
+			{
+				ResourceName:      "ibm_atracker_target.atracker_target_instance",
+				ImportState:       true,
+				ImportStateId:     "crn:v1:bluemix:public:atracker:us-south:a/" + MockAccountID + ":mock-target-id::",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestResourceIBMAtrackerTargetCosEndpointToMap(t *testing.T) {
 	checkResult := func(result map[string]interface{}) {
 		model := make(map[string]interface{})
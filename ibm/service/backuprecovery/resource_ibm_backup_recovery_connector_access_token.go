@@ -27,6 +27,9 @@ func ResourceIbmBackupRecoveryConnectorAccessToken() *schema.Resource {
 		CreateContext: resourceIbmBackupRecoveryConnectorAccessTokenCreate,
 		ReadContext:   resourceIbmBackupRecoveryConnectorAccessTokenRead,
 		DeleteContext: resourceIbmBackupRecoveryConnectorAccessTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		CustomizeDiff: checkDiffResourceIbmBackupRecoveryConnectorAccessToken,
 		UpdateContext: resourceIbmBackupRecoveryConnectorAccessTokenUpdate,
 
@@ -72,6 +75,11 @@ func checkDiffResourceIbmBackupRecoveryConnectorAccessToken(context context.Cont
 	if d.Id() == "" {
 		return nil
 	}
+	// An imported access token has no arguments in the state, they are taken
+	// from the configuration on the next apply
+	if username, _ := d.GetChange("username"); username.(string) == "" {
+		return nil
+	}
 
 	for fieldName := range ResourceIbmBackupRecoveryConnectorAccessToken().Schema {
 		if d.HasChange(fieldName) {
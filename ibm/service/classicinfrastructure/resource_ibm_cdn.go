@@ -27,6 +27,9 @@ func ResourceIBMCDN() *schema.Resource {
 		Delete:             resourceIBMCDNDelete,
 		Exists:             resourceIBMCDNExists,
 		DeprecationMessage: "This service is deprecated",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"host_name": {
 				Type:        schema.TypeString,
@@ -351,32 +354,41 @@ func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
 	cdnId := sl.String(d.Id())
 	///read the changes in the remote resource and update in the local resource.
 	read, err := service.ListDomainMappingByUniqueId(cdnId)
-	///Print the response of the requested the service.
-	d.Set("originaddress", *read[0].OriginHost)
-	d.Set("vendorname", *read[0].VendorName)
-	d.Set("domain", *read[0].Domain)
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving CDN mapping info: %s", err)
+	}
+	if len(read) == 0 {
+		log.Printf("[WARN] The CDN mapping %s is not found, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	///Set the arguments from the mapping, so that the resource can be imported by its ID.
+	d.Set("host_name", *read[0].Domain)
+	d.Set("vendor_name", *read[0].VendorName)
+	d.Set("origin_address", *read[0].OriginHost)
 	d.Set("header", *read[0].Header)
 	d.Set("cname", *read[0].Cname)
 	d.Set("origin_type", *read[0].OriginType)
 	d.Set("status", *read[0].Status)
 	if *read[0].OriginType == "OBJECT_STORAGE" {
-		d.Set("bucketname", *read[0].BucketName)
+		d.Set("bucket_name", *read[0].BucketName)
 	}
 	if *read[0].Protocol == "HTTP" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpport", *read[0].HttpPort)
+		d.Set("http_port", *read[0].HttpPort)
 	}
 	if *read[0].Protocol == "HTTPS" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpsport", *read[0].HttpsPort)
+		d.Set("https_port", *read[0].HttpsPort)
 	}
 	d.Set("protocol", *read[0].Protocol)
-	d.Set("respectheaders", *read[0].RespectHeaders)
-	d.Set("certificationtype", *read[0].CertificateType)
-	d.Set("cachekeyqueryrule", *read[0].CacheKeyQueryRule)
+	d.Set("respect_headers", *read[0].RespectHeaders)
+	d.Set("certificate_type", *read[0].CertificateType)
+	d.Set("cache_key_query_rule", *read[0].CacheKeyQueryRule)
 	d.Set("path", *read[0].Path)
-	d.Set("performanceconfiguration", *read[0].PerformanceConfiguration)
-	if err != nil {
-		log.Println(err)
-	}
+	d.Set("performance_configuration", *read[0].PerformanceConfiguration)
 	return nil
 }
 
@@ -20,6 +20,9 @@ func ResourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 		Read:   resourceIBMDNSDomainRegistrationNSRead,
 		Update: resourceIBMDNSDomainRegistrationNSUpdate,
 		Delete: resourceIBMDNSDomainRegistrationNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"dns_registration_id": {
 				Type:        schema.TypeString,
@@ -177,6 +180,7 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 	}
 
 	d.SetId(fmt.Sprintf("%d", dnsId))
+	d.Set("dns_registration_id", d.Id())
 	d.Set("name_servers", ns)
 	return nil
 }
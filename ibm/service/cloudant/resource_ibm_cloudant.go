@@ -408,7 +408,7 @@ func GetCloudantClientForUrl(endpoint string, meta interface{}) (*cloudantv1.Clo
 		}
 		authenticator = &core.IamAuthenticator{
 			ApiKey: apiKey,
-			URL:    conns.EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			URL:    meta.(conns.ClientSession).EndpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -61,10 +62,11 @@ const (
 )
 
 type DatabaseUser struct {
-	Username string
-	Password string
-	Role     *string
-	Type     string
+	Username        string
+	Password        string
+	PasswordVersion int
+	Role            *string
+	Type            string
 }
 
 type databaseUserValidationError struct {
@@ -223,6 +225,24 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				//  return true
 				// },
 			},
+			"adminpassword_wo": {
+				Description: "The admin user password for the instance, which is write-only and never stored in the state",
+				Type:        schema.TypeString,
+				Optional:    true,
+				WriteOnly:   true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(15, 72),
+					DatabaseUserPasswordValidator("database"),
+				),
+				Sensitive:     true,
+				ConflictsWith: []string{"adminpassword"},
+			},
+			"adminpassword_wo_version": {
+				Description:  "The version of adminpassword_wo. Change it to update the admin user password with the value of adminpassword_wo",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"adminpassword_wo"},
+			},
 			"configuration": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -323,12 +343,17 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(4, 32),
 						},
 						"password": {
-							Description:  "User password",
+							Description:  "User password. Required unless the password is set in users_password_wo",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringLenBetween(15, 32),
 						},
+						"password_wo_version": {
+							Description: "The version of the password of the user in users_password_wo. Change it to update the user password with the value of users_password_wo",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
 						"type": {
 							Description:  "User type",
 							Type:         schema.TypeString,
@@ -346,6 +371,35 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"users_password_wo": {
+				Description: "The write-only passwords of the users, which are never stored in the state",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "User name",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description:  "User type",
+							Type:         schema.TypeString,
+							Default:      "database",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+						},
+						"password": {
+							Description:  "User password",
+							Type:         schema.TypeString,
+							Required:     true,
+							WriteOnly:    true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(15, 32),
+						},
+					},
+				},
+			},
 			"allowlist": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -1282,7 +1336,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	//paramString := string(parameters[:])
 	rsInst.Parameters = raw
 
-	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(context, &rsInst)
 	if err != nil {
 		return diag.FromErr(
 			fmt.Errorf("[ERROR] Error creating database instance: %s %s", err, response))
@@ -1376,13 +1430,15 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	instanceID := *instance.ID
 	icdId := flex.EscapeUrlParm(instanceID)
 
-	if pw, ok := d.GetOk("adminpassword"); ok {
-		adminPassword := pw.(string)
-
+	adminPassword := d.Get("adminpassword").(string)
+	if adminPassword == "" {
+		adminPassword = flex.GetWriteOnlyString(d.GetRawConfig(), "adminpassword_wo")
+	}
+	if adminPassword != "" {
 		getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
 			ID: core.StringPtr(instanceID),
 		}
-		getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfo(getDeploymentInfoOptions)
+		getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
 
 		if err != nil {
 			if response.StatusCode == 404 {
@@ -1405,7 +1461,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 			User:     user,
 		}
 
-		updateUserResponse, response, err := cloudDatabasesClient.UpdateUser(updateUserOptions)
+		updateUserResponse, response, err := cloudDatabasesClient.UpdateUserWithContext(context, updateUserOptions)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] UpdateUser (%s) failed %s\n%s", *updateUserOptions.Username, err, response))
 		}
@@ -1493,7 +1549,12 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 
 		users := expandUsers(userList.(*schema.Set).List())
+		passwords := writeOnlyUserPasswords(d.GetRawConfig())
 		for _, user := range users {
+			if err := user.setWriteOnlyPassword(passwords); err != nil {
+				return diag.FromErr(err)
+			}
+
 			// Note: Some db users exist after provisioning (i.e. admin, repl)
 			// so we must attempt both methods
 			err := user.Update(instanceID, d, meta)
@@ -1526,7 +1587,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 			Configuration: configuration,
 		}
 
-		updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+		updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfigurationWithContext(context, updateDatabaseConfigurationOptions)
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
@@ -1565,7 +1626,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 				LogicalReplicationSlot: logicalReplicationSlot,
 			}
 
-			createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlot(createLogicalReplicationOptions)
+			createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlotWithContext(context, createLogicalReplicationOptions)
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] CreateLogicalReplicationSlot (%s) failed %s\n%s", *createLogicalReplicationOptions.LogicalReplicationSlot.Name, err, response))
 			}
@@ -1592,7 +1653,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rsInst)
 	if err != nil {
 		if strings.Contains(err.Error(), "Object not found") ||
 			strings.Contains(err.Error(), "status code: 404") {
@@ -1676,7 +1737,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
 		ID: core.StringPtr(instanceID),
 	}
-	getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfo(getDeploymentInfoOptions)
+	getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
 
 	if err != nil {
 		if response.StatusCode == 404 {
@@ -1693,7 +1754,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	listDeploymentScalingGroupsOptions := &clouddatabasesv5.ListDeploymentScalingGroupsOptions{
 		ID: core.StringPtr(instanceID),
 	}
-	groupList, _, err := cloudDatabasesClient.ListDeploymentScalingGroups(listDeploymentScalingGroupsOptions)
+	groupList, _, err := cloudDatabasesClient.ListDeploymentScalingGroupsWithContext(context, listDeploymentScalingGroupsOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database groups: %s", err))
 	}
@@ -1708,7 +1769,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 		GroupID: core.StringPtr("member"),
 	}
 
-	autoscalingGroup, _, err := cloudDatabasesClient.GetAutoscalingConditions(getAutoscalingConditionsOptions)
+	autoscalingGroup, _, err := cloudDatabasesClient.GetAutoscalingConditionsWithContext(context, getAutoscalingConditionsOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database autoscaling groups: %s\n Hint: Check if there is a mismatch between your database location and IBMCLOUD_REGION", err))
 	}
@@ -1718,7 +1779,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 		ID: &instanceID,
 	}
 
-	allowlist, _, err := cloudDatabasesClient.GetAllowlist(alEntry)
+	allowlist, _, err := cloudDatabasesClient.GetAllowlistWithContext(context, alEntry)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database allowlist: %s", err))
@@ -1790,7 +1851,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	}
 
 	if update {
-		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
+		_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, &updateReq)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
 		}
@@ -1840,7 +1901,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				Configuration: configuration,
 			}
 
-			updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+			updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfigurationWithContext(context, updateDatabaseConfigurationOptions)
 
 			if err != nil {
 				return diag.FromErr(fmt.Errorf(
@@ -1983,31 +2044,37 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("adminpassword") {
+	if d.HasChange("adminpassword") || d.HasChange("adminpassword_wo_version") {
 		adminUser := d.Get("adminuser").(string)
 		password := d.Get("adminpassword").(string)
-
-		user := &clouddatabasesv5.UserUpdatePasswordSetting{
-			Password: &password,
+		if d.HasChange("adminpassword_wo_version") {
+			password = flex.GetWriteOnlyString(d.GetRawConfig(), "adminpassword_wo")
 		}
 
-		updateUserOptions := &clouddatabasesv5.UpdateUserOptions{
-			ID:       core.StringPtr(instanceID),
-			UserType: core.StringPtr("database"),
-			Username: core.StringPtr(adminUser),
-			User:     user,
-		}
+		// Removing adminpassword or adminpassword_wo_version leaves the password as it is
+		if password != "" {
+			user := &clouddatabasesv5.UserUpdatePasswordSetting{
+				Password: &password,
+			}
 
-		updateUserResponse, response, err := cloudDatabasesClient.UpdateUser(updateUserOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] UpdateUser (%s) failed %s\n%s", *updateUserOptions.Username, err, response))
-		}
+			updateUserOptions := &clouddatabasesv5.UpdateUserOptions{
+				ID:       core.StringPtr(instanceID),
+				UserType: core.StringPtr("database"),
+				Username: core.StringPtr(adminUser),
+				User:     user,
+			}
 
-		taskID := *updateUserResponse.Task.ID
-		_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+			updateUserResponse, response, err := cloudDatabasesClient.UpdateUserWithContext(context, updateUserOptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] UpdateUser (%s) failed %s\n%s", *updateUserOptions.Username, err, response))
+			}
 
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error updating database admin password: %s", err))
+			taskID := *updateUserResponse.Task.ID
+			_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating database admin password: %s", err))
+			}
 		}
 	}
 
@@ -2048,6 +2115,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	if d.HasChange("users") {
 		oldUsers, newUsers := d.GetChange("users")
 		userChanges := expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List())
+		passwords := writeOnlyUserPasswords(d.GetRawConfig())
 
 		for _, change := range userChanges {
 			// Delete User
@@ -2061,6 +2129,9 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			}
 
 			if change.isCreate() || change.isUpdate() {
+				if err := change.New.setWriteOnlyPassword(passwords); err != nil {
+					return diag.FromErr(err)
+				}
 
 				// Note: User Update is not supported for ops_manager user type
 				// Delete (ignoring errors), then re-create
@@ -2126,7 +2197,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 					LogicalReplicationSlot: logicalReplicationSlot,
 				}
 
-				createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlot(createLogicalReplicationOptions)
+				createLogicalRepSlotResponse, response, err := cloudDatabasesClient.CreateLogicalReplicationSlotWithContext(context, createLogicalReplicationOptions)
 				if err != nil {
 					return diag.FromErr(fmt.Errorf("[ERROR] CreateLogicalReplicationSlot (%s) failed %s\n%s", *createLogicalReplicationOptions.LogicalReplicationSlot.Name, err, response))
 				}
@@ -2149,7 +2220,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 					Name: core.StringPtr(newEntry["name"].(string)),
 				}
 
-				deleteLogicalReplicationSlotResponse, response, err := cloudDatabasesClient.DeleteLogicalReplicationSlot(deleteLogicalReplicationSlotOptions)
+				deleteLogicalReplicationSlotResponse, response, err := cloudDatabasesClient.DeleteLogicalReplicationSlotWithContext(context, deleteLogicalReplicationSlotOptions)
 
 				if err != nil {
 					return diag.FromErr(fmt.Errorf(
@@ -2183,7 +2254,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 				},
 			}
 
-			promoteReadReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions)
+			promoteReadReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplicaWithContext(context, promoteReadOnlyReplicaOptions)
 
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error promoting read replica: %s\n%s", err, response))
@@ -2212,7 +2283,7 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 		Recursive: &recursive,
 		ID:        &id,
 	}
-	response, err := rsConClient.DeleteResourceInstance(&deleteReq)
+	response, err := rsConClient.DeleteResourceInstanceWithContext(context, &deleteReq)
 	if err != nil {
 		// If prior delete occurs, instance is not immediately deleted, but remains in "removed" state"
 		// RC 410 with "Gone" returned as error
@@ -3025,6 +3096,7 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 
 	oldUsers, newUsers := diff.GetChange("users")
 	userChanges := expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List())
+	passwords := writeOnlyUserPasswords(diff.GetRawConfig())
 
 	for _, change := range userChanges {
 		if change.isDelete() {
@@ -3032,10 +3104,17 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 		}
 
 		if change.isCreate() || change.isUpdate() {
-			err = change.New.ValidatePassword()
+			// The write-only password may not be known yet, it is then
+			// validated when the user is created or updated
+			if change.New.Password == "" {
+				change.New.Password = passwords[change.New.ID()]
+			}
+			if change.New.Password != "" {
+				err = change.New.ValidatePassword()
 
-			if err != nil {
-				return err
+				if err != nil {
+					return err
+				}
 			}
 
 			// TODO: Use Capability API
@@ -3078,6 +3157,10 @@ func expandUsers(_users []interface{}) []*DatabaseUser {
 				Type:     tfUser["type"].(string),
 			}
 
+			if version, ok := tfUser["password_wo_version"].(int); ok {
+				user.PasswordVersion = version
+			}
+
 			// NOTE: cannot differentiate nil vs empty string
 			// https://github.com/hashicorp/terraform-plugin-sdk/issues/741
 			if role, ok := tfUser["role"].(string); ok {
@@ -3119,6 +3202,37 @@ func expandUserChanges(_oldUsers []interface{}, _newUsers []interface{}) (userCh
 	return userChanges
 }
 
+// writeOnlyUserPasswords returns the passwords of users_password_wo by user
+// ID. They are write-only, so they are only available in the configuration.
+func writeOnlyUserPasswords(rawConfig cty.Value) map[string]string {
+	passwords := make(map[string]string)
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return passwords
+	}
+
+	users := rawConfig.GetAttr("users_password_wo")
+	if users.IsNull() || !users.IsKnown() {
+		return passwords
+	}
+
+	for it := users.ElementIterator(); it.Next(); {
+		_, tfUser := it.Element()
+		user := DatabaseUser{
+			Username: flex.GetWriteOnlyString(tfUser, "name"),
+			Type:     flex.GetWriteOnlyString(tfUser, "type"),
+		}
+		// Default values are not set in the configuration
+		if user.Type == "" {
+			user.Type = "database"
+		}
+		if password := flex.GetWriteOnlyString(tfUser, "password"); password != "" {
+			passwords[user.ID()] = password
+		}
+	}
+
+	return passwords
+}
+
 func validateRemoteLeaderIDDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
 	_, remoteLeaderIdOk := diff.GetOk("remote_leader_id")
 	service := diff.Get("service").(string)
@@ -3142,6 +3256,7 @@ func (c *userChange) isUpdate() bool {
 	return c.New != nil &&
 		c.Old != nil &&
 		((c.Old.Password != c.New.Password) ||
+			(c.Old.PasswordVersion != c.New.PasswordVersion) ||
 			(c.Old.Role != c.New.Role))
 }
 
@@ -3149,6 +3264,22 @@ func (u *DatabaseUser) ID() (id string) {
 	return fmt.Sprintf("%s-%s", u.Type, u.Username)
 }
 
+// setWriteOnlyPassword sets the password of a user without a password in
+// users to its password in users_password_wo.
+func (u *DatabaseUser) setWriteOnlyPassword(passwords map[string]string) error {
+	if u.Password != "" {
+		return nil
+	}
+
+	password, ok := passwords[u.ID()]
+	if !ok {
+		return fmt.Errorf("[ERROR] No password set for database user (%s), set either its password or its password in users_password_wo", u.Username)
+	}
+	u.Password = password
+
+	return nil
+}
+
 func (u *DatabaseUser) Create(instanceID string, d *schema.ResourceData, meta interface{}) (err error) {
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
 	if err != nil {
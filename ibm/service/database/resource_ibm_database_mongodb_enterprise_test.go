@@ -139,8 +139,8 @@ func TestAccIBMMongoDBEnterpriseDatabaseInstancePITR(t *testing.T) {
 				VersionConstraint: ">=0.9.1",
 			},
 		},
-		ProviderFactories: acc.TestAccProviderFactories(),
-		CheckDestroy:      testAccCheckIBMDatabaseInstanceDestroy,
+		ProtoV5ProviderFactories: acc.TestAccProviderFactories(),
+		CheckDestroy:             testAccCheckIBMDatabaseInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckIBMDatabaseInstanceMongoDBEnterpriseMinimal(databaseResourceGroup, serviceName),
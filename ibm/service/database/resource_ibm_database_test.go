@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"gotest.tools/assert"
 )
@@ -230,3 +231,63 @@ func TestPublicServiceEndpointsWarning(t *testing.T) {
 		t.Errorf("expected summary %v, got %v", warningNote, diags[0].Summary)
 	}
 }
+
+func TestWriteOnlyUserPasswords(t *testing.T) {
+	userType := cty.Object(map[string]cty.Type{
+		"name":     cty.String,
+		"type":     cty.String,
+		"password": cty.String,
+	})
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"users_password_wo": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("testy"),
+				"type":     cty.NullVal(cty.String),
+				"password": cty.StringVal("Pizzapizzapizza1"),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("testy"),
+				"type":     cty.StringVal("ops_manager"),
+				"password": cty.StringVal("Pizzapizzapizza2"),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("unknown"),
+				"type":     cty.NullVal(cty.String),
+				"password": cty.UnknownVal(cty.String),
+			}),
+		}),
+	})
+
+	passwords := writeOnlyUserPasswords(rawConfig)
+	assert.DeepEqual(t, map[string]string{
+		"database-testy":    "Pizzapizzapizza1",
+		"ops_manager-testy": "Pizzapizzapizza2",
+	}, passwords)
+
+	user := DatabaseUser{Username: "testy", Type: "database"}
+	assert.NilError(t, user.setWriteOnlyPassword(passwords))
+	assert.Equal(t, "Pizzapizzapizza1", user.Password)
+
+	user = DatabaseUser{Username: "testy", Type: "database", Password: "Pizzapizzapizza3"}
+	assert.NilError(t, user.setWriteOnlyPassword(passwords))
+	assert.Equal(t, "Pizzapizzapizza3", user.Password)
+
+	user = DatabaseUser{Username: "unknown", Type: "database"}
+	assert.ErrorContains(t, user.setWriteOnlyPassword(passwords), "No password set for database user (unknown)")
+
+	empty := cty.ObjectVal(map[string]cty.Value{
+		"users_password_wo": cty.NullVal(cty.List(userType)),
+	})
+	assert.Equal(t, 0, len(writeOnlyUserPasswords(empty)))
+}
+
+func TestUserChangeIsUpdateOnPasswordVersion(t *testing.T) {
+	change := userChange{
+		Old: &DatabaseUser{Username: "testy", Type: "database", PasswordVersion: 1},
+		New: &DatabaseUser{Username: "testy", Type: "database", PasswordVersion: 1},
+	}
+	assert.Assert(t, !change.isUpdate())
+
+	change.New.PasswordVersion = 2
+	assert.Assert(t, change.isUpdate())
+}
@@ -308,7 +308,7 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	}
 	config.Admin.Timeout = adminClientTimeout
 	config.Net.SASL.Mechanism = sarama.SASLTypeOAuth
-	config.Net.SASL.TokenProvider, err = newAccessTokenProvider(bxSession)
+	config.Net.SASL.TokenProvider, err = newAccessTokenProvider(bxSession, meta.(conns.ClientSession).EndpointFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamidentity.DefaultServiceURL))
 	if err != nil {
 		return nil, "", err
 	}
@@ -365,9 +365,9 @@ type accessTokenProvider struct {
 	authenticator *core.IamAuthenticator
 }
 
-func newAccessTokenProvider(sess *session.Session) (*accessTokenProvider, error) {
+func newAccessTokenProvider(sess *session.Session, iamURL string) (*accessTokenProvider, error) {
 	authenticator, err := core.NewIamAuthenticatorBuilder().
-		SetURL(conns.EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamidentity.DefaultServiceURL)).
+		SetURL(iamURL).
 		SetApiKey(sess.Config.BluemixAPIKey).
 		SetRefreshToken(sess.Config.IAMRefreshToken).
 		SetClientIDSecret("bx", "bx").
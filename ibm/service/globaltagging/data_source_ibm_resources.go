@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourcesSearchPageSize is the number of resources per Global Search
+// request, the maximum allowed.
+const resourcesSearchPageSize = 1000
+
+func DataSourceIBMResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Lucene query of the resources, for example resource_group_id:<id> AND region:us-south",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10000,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of resources returned",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources matching the query",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Global Search type of the resource, for example vpc or resource-instance",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the resource",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the resource",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags of the resource",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provider resource type of the resource, empty when the resource is not supported",
+						},
+						"import_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID to import the resource with, empty when the resource is not supported",
+						},
+					},
+				},
+			},
+			"import_blocks": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The import blocks of the supported resources, to write to a .tf file",
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourcesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_resources", "read")
+		return tfErr.GetDiag()
+	}
+
+	query := d.Get("query").(string)
+	maxResults := d.Get("max_results").(int)
+	var items []globalsearchv2.ResultItem
+	options := &globalsearchv2.SearchOptions{}
+	options.SetQuery(query)
+	options.SetFields([]string{"name", "type", "region", "resource_group_id", "tags"})
+	options.SetLimit(resourcesSearchPageSize)
+	for len(items) < maxResults {
+		result, response, err := gsClient.SearchWithContext(context, options)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("SearchWithContext failed: %s\n%s", err, response), "(Data) ibm_resources", "read")
+			return tfErr.GetDiag()
+		}
+		items = append(items, result.Items...)
+		if len(result.Items) < resourcesSearchPageSize || result.SearchCursor == nil {
+			break
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+	if len(items) > maxResults {
+		items = items[:maxResults]
+	}
+
+	providerRegion := meta.(conns.ClientSession).Region()
+	resources := make([]map[string]interface{}, 0, len(items))
+	var imports []importBlock
+	for _, item := range items {
+		resource := flattenResourcesItem(item)
+		resourceType, importID := resourceImportID(resource["crn"].(string), resource["type"].(string), resource["region"].(string), providerRegion)
+		resource["resource_type"] = resourceType
+		resource["import_id"] = importID
+		resources = append(resources, resource)
+		if resourceType != "" {
+			imports = append(imports, importBlock{resourceType: resourceType, name: resource["name"].(string), id: importID})
+		}
+	}
+
+	d.SetId(query)
+	if err := d.Set("resources", resources); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting resources: %s", err), "(Data) ibm_resources", "read")
+		return tfErr.GetDiag()
+	}
+	if err := d.Set("import_blocks", renderImportBlocks(imports)); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting import_blocks: %s", err), "(Data) ibm_resources", "read")
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func flattenResourcesItem(item globalsearchv2.ResultItem) map[string]interface{} {
+	resource := map[string]interface{}{"crn": ""}
+	if item.CRN != nil {
+		resource["crn"] = *item.CRN
+	}
+	for _, key := range []string{"name", "type", "region", "resource_group_id"} {
+		value, _ := item.GetProperty(key).(string)
+		resource[key] = value
+	}
+	tags := []interface{}{}
+	if values, ok := item.GetProperty("tags").([]interface{}); ok {
+		for _, v := range values {
+			if tag, ok := v.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	resource["tags"] = tags
+	return resource
+}
+
+// vpcResourceTypes maps the resource types of the CRNs of the VPC
+// Infrastructure Services to the provider resource types, whose import ID is
+// the ID of the resource.
+var vpcResourceTypes = map[string]string{
+	"backup-policy":      "ibm_is_backup_policy",
+	"bare-metal-server":  "ibm_is_bare_metal_server",
+	"dedicated-host":     "ibm_is_dedicated_host",
+	"endpoint-gateway":   "ibm_is_virtual_endpoint_gateway",
+	"flow-log-collector": "ibm_is_flow_log",
+	"floating-ip":        "ibm_is_floating_ip",
+	"image":              "ibm_is_image",
+	"instance":           "ibm_is_instance",
+	"instance-group":     "ibm_is_instance_group",
+	"instance-template":  "ibm_is_instance_template",
+	"key":                "ibm_is_ssh_key",
+	"load-balancer":      "ibm_is_lb",
+	"network-acl":        "ibm_is_network_acl",
+	"placement-group":    "ibm_is_placement_group",
+	"public-gateway":     "ibm_is_public_gateway",
+	"security-group":     "ibm_is_security_group",
+	"share":              "ibm_is_share",
+	"snapshot":           "ibm_is_snapshot",
+	"subnet":             "ibm_is_subnet",
+	"volume":             "ibm_is_volume",
+	"vpc":                "ibm_is_vpc",
+	"vpn":                "ibm_is_vpn_gateway",
+}
+
+// iamResourceTypes maps the resource types of the CRNs of the IAM services to
+// the provider resource types, whose import ID is the ID of the resource.
+var iamResourceTypes = map[string]string{
+	"access-group":   "ibm_iam_access_group",
+	"profile":        "ibm_iam_trusted_profile",
+	"resource-group": "ibm_resource_group",
+	"serviceid":      "ibm_iam_service_id",
+}
+
+// crossRegionLocations are the locations of the cross-region COS buckets.
+var crossRegionLocations = []string{"ap", "eu", "us"}
+
+// singleSiteLocation matches the locations of the single-site COS buckets,
+// such as ams03.
+var singleSiteLocation = regexp.MustCompile(`^[a-z]{3}[0-9]{2}$`)
+
+// resourceImportID returns the provider resource type of the resource of a
+// CRN, of the Global Search type searchType, and the ID to import it with,
+// empty strings when the resource is not supported. The import ID of a VPC resource of another region than the one
+// of the provider ends with @<region>, see the region argument of the
+// ibm_is_* resources.
+func resourceImportID(crn, searchType, region, providerRegion string) (string, string) {
+	// crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" {
+		return "", ""
+	}
+	serviceName, resourceType, resource := segments[4], segments[8], segments[9]
+
+	switch serviceName {
+	case "is":
+		if t, ok := vpcResourceTypes[resourceType]; ok && resource != "" {
+			if region != "" && region != providerRegion {
+				return t, resource + "@" + region
+			}
+			return t, resource
+		}
+		return "", ""
+	case "iam-groups", "iam-identity", "resource-controller":
+		if t, ok := iamResourceTypes[resourceType]; ok && resource != "" {
+			return t, resource
+		}
+		return "", ""
+	case "kms", "hs-crypto":
+		if resourceType == "key" && resource != "" {
+			return "ibm_kms_key", crn
+		}
+	case "cloud-object-storage":
+		if resourceType == "bucket" && resource != "" && region != "" {
+			bucketType := "rl"
+			if flex.StringContains(crossRegionLocations, region) {
+				bucketType = "crl"
+			} else if singleSiteLocation.MatchString(region) {
+				bucketType = "ssl"
+			}
+			return "ibm_cos_bucket", fmt.Sprintf("%s:meta:%s:%s", crn, bucketType, region)
+		}
+	}
+	if searchType == "resource-instance" {
+		return "ibm_resource_instance", crn
+	}
+	return "", ""
+}
+
+type importBlock struct {
+	resourceType, name, id string
+}
+
+var nonLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// renderImportBlocks returns the import blocks of the resources, labelled
+// after their names, made unique per resource type.
+func renderImportBlocks(imports []importBlock) string {
+	var b strings.Builder
+	labels := map[string]bool{}
+	for _, i := range imports {
+		label := strings.Trim(nonLabelCharacters.ReplaceAllString(strings.ToLower(i.name), "_"), "_")
+		if label == "" {
+			label = "resource"
+		} else if label[0] >= '0' && label[0] <= '9' {
+			label = "r_" + label
+		}
+		unique := label
+		for n := 2; labels[i.resourceType+"."+unique]; n++ {
+			unique = fmt.Sprintf("%s_%d", label, n)
+		}
+		labels[i.resourceType+"."+unique] = true
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %q\n}\n\n", i.resourceType, unique, i.id)
+	}
+	return b.String()
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	. "github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourcesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_resources.vpcs", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_resources.vpcs", "resources.0.crn"),
+					resource.TestCheckResourceAttr("data.ibm_resources.vpcs", "resources.0.resource_type", "ibm_is_vpc"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcesDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_resources" "vpcs" {
+		query       = "family:is AND type:vpc AND region:%s"
+		max_results = 10
+	}
+`, acc.RegionName)
+}
+
+func TestDataSourceIBMResourcesMock(t *testing.T) {
+	mock := NewMockServer(t)
+	account := "a/" + MockAccountID
+	mock.Expect("global_search", http.MethodPost, "/v3/resources/search").Times(1).RespondWith(func(r *http.Request, body []byte) (int, interface{}) {
+		var search map[string]interface{}
+		json.Unmarshal(body, &search)
+		if search["query"] != "resource_group_id:mock-rg" {
+			return http.StatusBadRequest, `{"errors": [{"code": "bad_query", "message": "unexpected query"}]}`
+		}
+		return http.StatusOK, map[string]interface{}{
+			"search_cursor": "mock-cursor",
+			"limit":         1000,
+			"items": []map[string]interface{}{
+				{
+					"crn":               "crn:v1:bluemix:public:is:us-south:" + account + "::vpc:r006-1111",
+					"name":              "Web VPC",
+					"type":              "vpc",
+					"region":            "us-south",
+					"resource_group_id": "mock-rg",
+					"tags":              []string{"env:dev"},
+				},
+				{
+					"crn":               "crn:v1:bluemix:public:is:eu-de:" + account + "::vpc:r010-2222",
+					"name":              "web-vpc",
+					"type":              "vpc",
+					"region":            "eu-de",
+					"resource_group_id": "mock-rg",
+				},
+				{
+					"crn":               "crn:v1:bluemix:public:cloud-object-storage:global:" + account + ":3333::",
+					"name":              "1-cos",
+					"type":              "resource-instance",
+					"region":            "global",
+					"resource_group_id": "mock-rg",
+				},
+				{
+					"crn":               "crn:v1:bluemix:public:cloud-object-storage:global:" + account + ":3333:bucket:logs",
+					"name":              "logs",
+					"type":              "bucket",
+					"region":            "eu",
+					"resource_group_id": "mock-rg",
+				},
+				{
+					"crn":               "crn:v1:bluemix:public:containers-kubernetes:us-south:" + account + ":cluster4444::",
+					"name":              "cluster",
+					"type":              "k8-cluster",
+					"region":            "us-south",
+					"resource_group_id": "mock-rg",
+				},
+			},
+		}
+	})
+
+	importBlocks := `import {
+  to = ibm_is_vpc.web_vpc
+  id = "r006-1111"
+}
+
+import {
+  to = ibm_is_vpc.web_vpc_2
+  id = "r010-2222@eu-de"
+}
+
+import {
+  to = ibm_resource_instance.r_1_cos
+  id = "crn:v1:bluemix:public:cloud-object-storage:global:a/` + MockAccountID + `:3333::"
+}
+
+import {
+  to = ibm_cos_bucket.logs
+  id = "crn:v1:bluemix:public:cloud-object-storage:global:a/` + MockAccountID + `:3333:bucket:logs:meta:crl:eu"
+}
+
+`
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: mock.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "ibm_resources" "resources" {
+						query = "resource_group_id:mock-rg"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.#", "5"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.0.name", "Web VPC"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.0.tags.0", "env:dev"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.0.resource_type", "ibm_is_vpc"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.1.import_id", "r010-2222@eu-de"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.2.resource_type", "ibm_resource_instance"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.3.resource_type", "ibm_cos_bucket"),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.4.resource_type", ""),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "resources.4.import_id", ""),
+					resource.TestCheckResourceAttr("data.ibm_resources.resources", "import_blocks", importBlocks),
+				),
+			},
+		},
+	})
+}
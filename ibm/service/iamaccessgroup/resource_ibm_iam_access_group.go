@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,6 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// newAccessGroupReadTimeout is how long the reads of a new access group are
+// retried, until the access group is visible to the reads after its creation.
+const newAccessGroupReadTimeout = 2 * time.Minute
+
 func ResourceIBMIAMAccessGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMIAMAccessGroupCreate,
@@ -74,7 +79,7 @@ func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.Resource
 		description := des.(string)
 		creatAccessGroupOptions.Description = &description
 	}
-	agrp, detailedResponse, err := iamAccessGroupsClient.CreateAccessGroup(creatAccessGroupOptions)
+	agrp, detailedResponse, err := iamAccessGroupsClient.CreateAccessGroupWithContext(context, creatAccessGroupOptions)
 	if err != nil || agrp == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error creating access group: %s. API Response: %s", err, detailedResponse))
 	}
@@ -94,8 +99,12 @@ func resourceIBMIAMAccessGroupRead(context context.Context, d *schema.ResourceDa
 	getAccessGroupOptions.SetShowCRN(true)
 	var agrp *iamaccessgroupsv2.Group
 	var detailedResponse *core.DetailedResponse
-	err = resource.RetryContext(context, 5*time.Second, func() *resource.RetryError {
-		agrp, detailedResponse, err = iamAccessGroupsClient.GetAccessGroup(getAccessGroupOptions)
+	timeout := 5 * time.Second
+	if d.IsNewResource() {
+		timeout = newAccessGroupReadTimeout
+	}
+	err = resource.RetryContext(context, timeout, func() *resource.RetryError {
+		agrp, detailedResponse, err = iamAccessGroupsClient.GetAccessGroupWithContext(context, getAccessGroupOptions)
 		if err != nil || agrp == nil {
 			if detailedResponse != nil && detailedResponse.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -106,10 +115,16 @@ func resourceIBMIAMAccessGroupRead(context context.Context, d *schema.ResourceDa
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		agrp, detailedResponse, err = iamAccessGroupsClient.GetAccessGroup(getAccessGroupOptions)
+		agrp, detailedResponse, err = iamAccessGroupsClient.GetAccessGroupWithContext(context, getAccessGroupOptions)
 	}
 	if err != nil || agrp == nil {
 		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+			if d.IsNewResource() {
+				// The access group was just created and is still not visible
+				// to the reads after the retries, keep it in the state and
+				// read it again at the next refresh
+				return flex.TerraformWarningf(err, fmt.Sprintf("The access group (%s) is still not found %s after its creation, it is read again at the next refresh", agrpID, newAccessGroupReadTimeout), "ibm_iam_access_group", "read").GetDiag()
+			}
 			d.SetId("")
 			return nil
 		}
@@ -147,7 +162,7 @@ func resourceIBMIAMAccessGroupUpdate(context context.Context, d *schema.Resource
 	}
 
 	if hasChange {
-		agrp, detailedResponse, err := iamAccessGroupsClient.UpdateAccessGroup(updateAccessGroupOptions)
+		agrp, detailedResponse, err := iamAccessGroupsClient.UpdateAccessGroupWithContext(context, updateAccessGroupOptions)
 		if err != nil || agrp == nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating access group: %s. API Response: %s", err, detailedResponse))
 		}
@@ -167,7 +182,7 @@ func resourceIBMIAMAccessGroupDelete(context context.Context, d *schema.Resource
 	force := true
 	deleteAccessGroupOptions := iamAccessGroupsClient.NewDeleteAccessGroupOptions(agID)
 	deleteAccessGroupOptions.SetForce(force)
-	detailedResponse, err := iamAccessGroupsClient.DeleteAccessGroup(deleteAccessGroupOptions)
+	detailedResponse, err := iamAccessGroupsClient.DeleteAccessGroupWithContext(context, deleteAccessGroupOptions)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting access group: %s, API Response: %s", err, detailedResponse))
 	}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &iamAuthTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &iamAuthTokenEphemeralResource{}
+)
+
+// iamTokenRefreshMargin is the validity left below which Open refreshes the
+// IAM access token of the provider before returning it.
+const iamTokenRefreshMargin = 10 * time.Minute
+
+// NewIAMAuthTokenEphemeralResource returns the ibm_iam_auth_token ephemeral
+// resource, the tokens of the ibm_iam_auth_token data source that are never
+// written to the plan or the state.
+func NewIAMAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &iamAuthTokenEphemeralResource{}
+}
+
+type iamAuthTokenEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type iamAuthTokenModel struct {
+	IAMAccessToken  types.String `tfsdk:"iam_access_token"`
+	IAMRefreshToken types.String `tfsdk:"iam_refresh_token"`
+	UAAAccessToken  types.String `tfsdk:"uaa_access_token"`
+	UAARefreshToken types.String `tfsdk:"uaa_refresh_token"`
+}
+
+func (r *iamAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_auth_token"
+}
+
+func (r *iamAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The IAM and UAA tokens of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"iam_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM access token, including the Bearer prefix.",
+			},
+			"iam_refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM refresh token.",
+			},
+			"uaa_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The UAA access token.",
+			},
+			"uaa_refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The UAA refresh token.",
+			},
+		},
+	}
+}
+
+func (r *iamAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if session, ok := req.ProviderData.(conns.ClientSession); ok {
+		r.session = session
+	}
+}
+
+// Open returns the tokens of the provider, refreshed first when the access
+// token expires within iamTokenRefreshMargin. Terraform renews the ephemeral
+// resource when the access token expires, see Renew.
+func (r *iamAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.session == nil {
+		tfErr := flex.TerraformErrorf(nil, "The provider is not configured", "(Ephemeral) ibm_iam_auth_token", "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	bmxSess, err := r.session.BluemixSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error getting the IBM Cloud session", "(Ephemeral) ibm_iam_auth_token", "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	expiresAt, ok := iamTokenExpiry(bmxSess.Config.IAMAccessToken)
+	if ok && time.Until(expiresAt) < iamTokenRefreshMargin && bmxSess.Config.IAMRefreshToken != "" {
+		if err := conns.RefreshToken(bmxSess); err != nil {
+			tfErr := flex.TerraformErrorf(err, "Error refreshing the IAM access token", "(Ephemeral) ibm_iam_auth_token", "open")
+			resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+			return
+		}
+		expiresAt, ok = iamTokenExpiry(bmxSess.Config.IAMAccessToken)
+	}
+	if ok {
+		resp.RenewAt = expiresAt
+	}
+
+	token := iamAuthTokenModel{
+		IAMAccessToken:  types.StringValue(bmxSess.Config.IAMAccessToken),
+		IAMRefreshToken: types.StringValue(bmxSess.Config.IAMRefreshToken),
+		UAAAccessToken:  types.StringValue(bmxSess.Config.UAAAccessToken),
+		UAARefreshToken: types.StringValue(bmxSess.Config.UAARefreshToken),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &token)...)
+}
+
+// Renew reports that the access token returned by Open has expired: an
+// ephemeral resource cannot change its result once it is open, so the
+// resources that still use the token fail to authenticate.
+func (r *iamAuthTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	tfErr := flex.TerraformWarningf(nil, "The IAM access token has expired and cannot be renewed during the run, the API calls that still use it fail to authenticate", "(Ephemeral) ibm_iam_auth_token", "renew")
+	resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+}
+
+// iamTokenExpiry returns the expiry of the IAM access token, read from its
+// claims without verifying its signature.
+func iamTokenExpiry(token string) (time.Time, bool) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(token, "Bearer "), claims); err != nil {
+		return time.Time{}, false
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		return time.Time{}, false
+	}
+	return expiresAt.Time, true
+}
@@ -52,6 +52,21 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Sensitive:   true,
 				Description: "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
 			},
+			"apikey_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"apikey"},
+				Description:   "You can optionally passthrough the API key value for this API key, which is write-only and never stored in the state. The same rules as for apikey apply.",
+			},
+			"apikey_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"apikey_wo"},
+				Description:  "The version of apikey_wo. The value of an API key cannot be changed, change it to replace the API key with one with the value of apikey_wo.",
+			},
 			"store_value": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -130,8 +145,11 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("description"); ok {
 		createApiKeyOptions.SetDescription(d.Get("description").(string))
 	}
+	apikeyWriteOnly := flex.GetWriteOnlyString(d.GetRawConfig(), "apikey_wo")
 	if _, ok := d.GetOk("apikey"); ok {
 		createApiKeyOptions.SetApikey(d.Get("apikey").(string))
+	} else if apikeyWriteOnly != "" {
+		createApiKeyOptions.SetApikey(apikeyWriteOnly)
 	}
 	if _, ok := d.GetOk("store_value"); ok {
 		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
@@ -140,14 +158,17 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 		createApiKeyOptions.SetEntityLock(d.Get("locked").(string))
 	}
 
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
+	apiKey, response, err := iamIdentityClient.CreateAPIKeyWithContext(context, createApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
 	}
 
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
+	// The API key is not stored in the state when it is set with apikey_wo
+	if apikeyWriteOnly == "" {
+		d.Set("apikey", *apiKey.Apikey)
+	}
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
@@ -168,7 +189,7 @@ func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, m
 
 	getApiKeyOptions.SetID(d.Id())
 
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getApiKeyOptions)
+	apiKey, response, err := iamIdentityClient.GetAPIKeyWithContext(context, getApiKeyOptions)
 	if err != nil || apiKey == nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -229,7 +250,7 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("description"); ok {
 		updateApiKeyOptions.SetDescription(d.Get("description").(string))
 	}
-	_, response, err := iamIdentityClient.UpdateAPIKey(updateApiKeyOptions)
+	_, response, err := iamIdentityClient.UpdateAPIKeyWithContext(context, updateApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -248,7 +269,7 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 
 	deleteApiKeyOptions.SetID(d.Id())
 
-	response, err := iamIdentityClient.DeleteAPIKey(deleteApiKeyOptions)
+	response, err := iamIdentityClient.DeleteAPIKeyWithContext(context, deleteApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteApiKey failed %s\n%s", err, response)
 		return diag.FromErr(err)
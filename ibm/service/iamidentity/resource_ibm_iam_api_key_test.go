@@ -84,6 +84,37 @@ func TestAccIbmIamApiKeyAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmIamApiKeyWriteOnly(t *testing.T) {
+	var conf iamidentityv1.APIKey
+	name := fmt.Sprintf("name_%d", acctest.RandIntRange(10, 100))
+	apikey := acctest.RandString(40)
+	apikeyUpdate := acctest.RandString(40)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIamApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamApiKeyConfigWriteOnly(name, apikey, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIamApiKeyExists("ibm_iam_api_key.iam_api_key", conf),
+					resource.TestCheckNoResourceAttr("ibm_iam_api_key.iam_api_key", "apikey"),
+					resource.TestCheckNoResourceAttr("ibm_iam_api_key.iam_api_key", "apikey_wo"),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "apikey_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIamApiKeyConfigWriteOnly(name, apikeyUpdate, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("ibm_iam_api_key.iam_api_key", "apikey"),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "apikey_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIamApiKeyConfigBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -93,6 +124,17 @@ func testAccCheckIbmIamApiKeyConfigBasic(name string) string {
 	`, name)
 }
 
+func testAccCheckIbmIamApiKeyConfigWriteOnly(name string, apikey string, version int) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_api_key" "iam_api_key" {
+			name              = "%s"
+			apikey_wo         = "%s"
+			apikey_wo_version = %d
+		}
+	`, name, apikey, version)
+}
+
 func testAccCheckIbmIamApiKeyConfig(name string, description string, storeValue string) string {
 	return fmt.Sprintf(`
 
@@ -17,6 +17,9 @@ func ResourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 		Read:   resourceIBMIAMAuthorizationPolicyDetachRead,
 		Delete: resourceIBMIAMAuthorizationPolicyDetachDelete,
 		Exists: resourceIBMIAMAuthorizationPolicyDetachExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMIAMAuthorizationPolicyDetachImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authorization_policy_id": {
@@ -53,6 +56,12 @@ func resourceIBMIAMAuthorizationPolicyDetachRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+// The import ID is the ID of the detached authorization policy.
+func resourceIBMIAMAuthorizationPolicyDetachImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("authorization_policy_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMIAMAuthorizationPolicyDetachDelete(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId("")
@@ -147,7 +147,7 @@ func dataSourceIBMContainerDedicatedHostRead(ctx context.Context, d *schema.Reso
 	hostPoolID := d.Get("host_pool_id").(string)
 	id := fmt.Sprintf("%s/%s", hostPoolID, hostID)
 
-	if err := getIBMContainerDedicatedHost(id, d, meta); err != nil {
+	if err := getIBMContainerDedicatedHost(ctx, id, d, meta); err != nil {
 		return diag.Errorf("[ERROR] getIBMContainerDedicatedHost failed: %v", err)
 	}
 
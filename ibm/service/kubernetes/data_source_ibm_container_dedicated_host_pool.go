@@ -100,7 +100,7 @@ func DataSourceIBMContainerDedicatedHostPool() *schema.Resource {
 
 func dataSourceIBMContainerDedicatedHostPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	hostPoolID := d.Get("host_pool_id").(string)
-	err := getIBMContainerDedicatedHostPool(hostPoolID, d, meta)
+	err := getIBMContainerDedicatedHostPool(ctx, hostPoolID, d, meta)
 	if err != nil {
 		return diag.Errorf("[ERROR] Error retrieving host pool details %v", err)
 	}
@@ -17,6 +17,9 @@ func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 		Read:   resourceIBMContainerAPIKeyResetRead,
 		Update: resourceIBMContainerAPIKeyResetUpdate,
 		Delete: resourceIBMContainerAPIKeyResetdelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMContainerAPIKeyResetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -75,6 +78,23 @@ func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interfac
 func resourceIBMContainerAPIKeyResetRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
+
+// The ID of the resource is <region>/<resource group ID>. An imported reset
+// records the default reset_api_key, so that only a change of reset_api_key
+// resets the API key again.
+func resourceIBMContainerAPIKeyResetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of region/resourceGroupID", d.Id())
+	}
+	d.Set("region", parts[0])
+	d.Set("resource_group_id", parts[1])
+	d.Set("reset_api_key", 1)
+	return []*schema.ResourceData{d}, nil
+}
 func resourceIBMContainerAPIKeyResetdelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
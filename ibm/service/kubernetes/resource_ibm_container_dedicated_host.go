@@ -186,7 +186,7 @@ func ResourceIBMContainerDedicatedHost() *schema.Resource {
 }
 
 func resourceIBMContainerDedicatedHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -247,14 +247,14 @@ func resourceIBMContainerDedicatedHostCreate(ctx context.Context, d *schema.Reso
 
 func resourceIBMContainerDedicatedHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
-	if err := getIBMContainerDedicatedHost(id, d, meta); err != nil {
+	if err := getIBMContainerDedicatedHost(ctx, id, d, meta); err != nil {
 		return diag.Errorf("[ERROR] getIBMContainerDedicatedHost failed: %v", err)
 	}
 	return nil
 }
 
-func getIBMContainerDedicatedHost(id string, d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+func getIBMContainerDedicatedHost(ctx context.Context, id string, d *schema.ResourceData, meta interface{}) error {
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return err
 	}
@@ -288,7 +288,7 @@ func getIBMContainerDedicatedHost(id string, d *schema.ResourceData, meta interf
 }
 
 func resourceIBMContainerDedicatedHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -329,7 +329,7 @@ func resourceIBMContainerDedicatedHostUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceIBMContainerDedicatedHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -502,3 +502,13 @@ func setDedicatedHostFields(d *schema.ResourceData, dedicatedHost v2.GetDedicate
 	}
 	d.Set("workers", workers)
 }
+
+// vpcContainerAPIWithContext returns the VPC container client whose API calls
+// are attributed to the operation of ctx, see conns.BluemixSessionWithContext.
+func vpcContainerAPIWithContext(ctx context.Context, meta interface{}) (v2.ContainerServiceAPI, error) {
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	return v2.New(conns.BluemixSessionWithContext(ctx, sess))
+}
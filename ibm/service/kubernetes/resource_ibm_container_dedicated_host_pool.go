@@ -129,7 +129,7 @@ func ResourceIBMContainerDedicatedHostPool() *schema.Resource {
 }
 
 func resourceIBMContainerDedicatedHostPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -164,7 +164,7 @@ func resourceIBMContainerDedicatedHostPoolCreate(ctx context.Context, d *schema.
 }
 
 func resourceIBMContainerDedicatedHostPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	err := getIBMContainerDedicatedHostPool(d.Id(), d, meta)
+	err := getIBMContainerDedicatedHostPool(ctx, d.Id(), d, meta)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
 			if apiErr.StatusCode() == 404 {
@@ -177,8 +177,8 @@ func resourceIBMContainerDedicatedHostPoolRead(ctx context.Context, d *schema.Re
 	return nil
 }
 
-func getIBMContainerDedicatedHostPool(hostPoolID string, d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+func getIBMContainerDedicatedHostPool(ctx context.Context, hostPoolID string, d *schema.ResourceData, meta interface{}) error {
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return err
 	}
@@ -226,7 +226,7 @@ func setDedicatedHostPoolFields(dedicatedHostPool v2.GetDedicatedHostPoolRespons
 }
 
 func resourceIBMContainerDedicatedHostPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	client, err := vpcContainerAPIWithContext(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
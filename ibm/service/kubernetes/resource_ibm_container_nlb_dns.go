@@ -22,6 +22,9 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 		ReadContext:   resourceIbmContainerNlbDnsRead,
 		UpdateContext: resourceIbmContainerNlbDnsUpdate,
 		DeleteContext: resourceIbmContainerNlbDnsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -90,7 +90,7 @@ func getClientWithLogsInstanceEndpoint(originalClient *logsv0.LogsV0, meta inter
 		Service: originalClient.Service.Clone(),
 	}
 
-	endpoint = conns.EnvFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, endpoint)
+	endpoint = meta.(conns.ClientSession).EndpointFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, endpoint)
 
 	log.Printf("Constructing client with new service URL %s", endpoint)
 
@@ -131,6 +131,7 @@ func ResourceIBMPICapture() *schema.Resource {
 }
 
 func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -207,12 +208,12 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imagedata.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi capture (%s) pi_user_tags during creation: %s", *imagedata.ImageID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi capture (%s) pi_user_tags during creation: %s", *imagedata.ImageID, err), "ibm_pi_capture", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPICaptureRead(ctx, d, meta)
+	return append(diags, resourceIBMPICaptureRead(ctx, d, meta)...)
 }
 
 func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -288,6 +289,7 @@ func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -300,10 +302,10 @@ func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi capture (%s) pi_user_tags: %s", captureID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi capture (%s) pi_user_tags: %s", captureID, err), "ibm_pi_capture", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPICaptureRead(ctx, d, meta)
+	return append(diags, resourceIBMPICaptureRead(ctx, d, meta)...)
 }
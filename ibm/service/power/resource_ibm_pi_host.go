@@ -183,6 +183,7 @@ func ResourceIBMPIHost() *schema.Resource {
 }
 
 func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -223,11 +224,11 @@ func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta i
 		oldList, newList := d.GetChange(Arg_Host + ".0." + Attr_UserTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(hostResponse[0].Crn), "", UserTagType)
 		if err != nil {
-			log.Printf("Error on update of pi host (%s) user_tags during creation: %s", hostResponse[0].ID, err)
+			diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi host (%s) user_tags during creation: %s", hostResponse[0].ID, err), "ibm_pi_host", "create").WithAttribute(Arg_Host+".0."+Attr_UserTags).GetDiag()...)
 		}
 	}
 
-	return resourceIBMPIHostRead(ctx, d, meta)
+	return append(diags, resourceIBMPIHostRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -288,6 +289,7 @@ func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -319,14 +321,14 @@ func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			if !userTagsNew.Equal(userTagsOld) {
 				err = flex.UpdateGlobalTagsUsingCRN(userTagsOld, userTagsNew, meta, crn.(string), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi host (%s) pi_host user_tags: %s", d.Get(Attr_HostID), err)
+					diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi host (%s) pi_host user_tags: %s", d.Get(Attr_HostID), err), "ibm_pi_host", "update").WithAttribute(Arg_Host+".0."+Attr_UserTags).GetDiag()...)
 				}
 			}
 		}
 
 	}
 
-	return resourceIBMPIHostRead(ctx, d, meta)
+	return append(diags, resourceIBMPIHostRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -222,6 +222,7 @@ func ResourceIBMPIImage() *schema.Resource {
 }
 
 func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		log.Printf("Failed to get the session")
@@ -262,7 +263,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 				oldList, newList := d.GetChange(Arg_UserTags)
 				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imageResponse.Crn), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *IBMPIImageID, err)
+					diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi image (%s) pi_user_tags during creation: %s", *IBMPIImageID, err), "ibm_pi_image", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 				}
 			}
 		}
@@ -357,14 +358,14 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 				oldList, newList := d.GetChange(Arg_UserTags)
 				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(image.Crn), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *image.ImageID, err)
+					diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi image (%s) pi_user_tags during creation: %s", *image.ImageID, err), "ibm_pi_image", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 				}
 			}
 		}
 		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *image.ImageID))
 	}
 
-	return resourceIBMPIImageRead(ctx, d, meta)
+	return append(diags, resourceIBMPIImageRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -409,6 +410,7 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceIBMPIImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	_, imageID, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -419,12 +421,12 @@ func resourceIBMPIImageUpdate(ctx context.Context, d *schema.ResourceData, meta
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi image (%s) pi_user_tags: %s", imageID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi image (%s) pi_user_tags: %s", imageID, err), "ibm_pi_image", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPIImageRead(ctx, d, meta)
+	return append(diags, resourceIBMPIImageRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -493,6 +493,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 }
 
 func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -564,7 +565,7 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 			if s.Crn != "" {
 				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(s.Crn), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err)
+					diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err), "ibm_pi_instance", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 				}
 			}
 		}
@@ -585,7 +586,7 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -709,6 +710,7 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	name := d.Get(Arg_InstanceName).(string)
 	mem := d.Get(Arg_Memory).(float64)
 	procs := d.Get(Arg_Processors).(float64)
@@ -1008,7 +1010,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err), "ibm_pi_instance", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
@@ -1083,7 +1085,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -23,6 +23,9 @@ func ResourceIBMPIInstanceConsoleLanguage() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceConsoleLanguageRead,
 		UpdateContext: resourceIBMPIInstanceConsoleLanguageUpdate,
 		DeleteContext: resourceIBMPIInstanceConsoleLanguageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -81,7 +84,14 @@ func resourceIBMPIInstanceConsoleLanguageCreate(ctx context.Context, d *schema.R
 }
 
 func resourceIBMPIInstanceConsoleLanguageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// There is no get concept for instance console language
+	// There is no get concept for instance console language, only the
+	// instance is read from the ID, e.g. on import
+	cloudInstanceID, instanceName, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_InstanceName, instanceName)
 	return nil
 }
 
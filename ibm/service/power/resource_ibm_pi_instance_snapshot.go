@@ -110,6 +110,7 @@ func ResourceIBMPIInstanceSnapshot() *schema.Resource {
 }
 
 func resourceIBMPIInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -158,12 +159,12 @@ func resourceIBMPIInstanceSnapshotCreate(ctx context.Context, d *schema.Resource
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err), "ibm_pi_instance_snapshot", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPIInstanceSnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceSnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -202,6 +203,7 @@ func resourceIBMPIInstanceSnapshotRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceIBMPIInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -235,12 +237,12 @@ func resourceIBMPIInstanceSnapshotUpdate(ctx context.Context, d *schema.Resource
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err), "ibm_pi_instance_snapshot", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPIInstanceSnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPIInstanceSnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
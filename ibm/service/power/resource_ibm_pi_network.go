@@ -213,6 +213,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 }
 
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -316,12 +317,12 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(networkResponse.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", networkID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", networkID, err), "ibm_pi_network", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPINetworkRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -383,6 +384,7 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -427,12 +429,12 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network (%s) pi_user_tags: %s", networkID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi network (%s) pi_user_tags: %s", networkID, err), "ibm_pi_network", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPINetworkRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
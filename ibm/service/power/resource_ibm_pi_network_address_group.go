@@ -97,6 +97,7 @@ func ResourceIBMPINetworkAddressGroup() *schema.Resource {
 }
 
 func resourceIBMPINetworkAddressGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -122,13 +123,13 @@ func resourceIBMPINetworkAddressGroupCreate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkAddressGroup.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network address group (%s) pi_user_tags during creation: %s", *networkAddressGroup.ID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi network address group (%s) pi_user_tags during creation: %s", *networkAddressGroup.ID, err), "ibm_pi_network_address_group", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *networkAddressGroup.ID))
 
-	return resourceIBMPINetworkAddressGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkAddressGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkAddressGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -176,6 +177,7 @@ func resourceIBMPINetworkAddressGroupRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -192,7 +194,7 @@ func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network address group (%s) pi_user_tags: %s", parts[1], err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi network address group (%s) pi_user_tags: %s", parts[1], err), "ibm_pi_network_address_group", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
@@ -208,7 +210,7 @@ func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	return resourceIBMPINetworkAddressGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkAddressGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkAddressGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -150,6 +150,7 @@ func ResourceIBMPINetworkInterface() *schema.Resource {
 }
 
 func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -184,7 +185,7 @@ func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.Resource
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *crn, "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", networkInterfaceID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of network interface (%s) pi_user_tags: %s", networkInterfaceID, err), "ibm_pi_network_interface", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
@@ -204,7 +205,7 @@ func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.Resource
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, networkID, networkInterfaceID))
 
-	return resourceIBMPINetworkInterfaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkInterfaceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -250,6 +251,7 @@ func resourceIBMPINetworkInterfaceRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -267,7 +269,7 @@ func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.Resource
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", parts[2], err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of network interface (%s) pi_user_tags: %s", parts[2], err), "ibm_pi_network_interface", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
@@ -295,7 +297,7 @@ func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	return resourceIBMPINetworkInterfaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkInterfaceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
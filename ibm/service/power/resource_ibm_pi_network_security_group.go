@@ -226,6 +226,7 @@ func ResourceIBMPINetworkSecurityGroup() *schema.Resource {
 }
 
 func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -252,14 +253,14 @@ func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.Reso
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkSecurityGroup.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags during creation: %s", *networkSecurityGroup.ID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi network security group (%s) pi_user_tags during creation: %s", *networkSecurityGroup.ID, err), "ibm_pi_network_security_group", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 	nsgID := *networkSecurityGroup.ID
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, nsgID))
 
-	return resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -320,6 +321,7 @@ func resourceIBMPINetworkSecurityGroupRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -333,7 +335,7 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags: %s", nsgID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi network security group (%s) pi_user_tags: %s", nsgID, err), "ibm_pi_network_security_group", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
@@ -347,7 +349,7 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 			return diag.FromErr(err)
 		}
 	}
-	return resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)
+	return append(diags, resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)...)
 }
 
 func resourceIBMPINetworkSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -195,6 +195,7 @@ func ResourceIBMPISharedProcessorPool() *schema.Resource {
 }
 
 func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -242,12 +243,12 @@ func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(spp.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi shared processor pool (%s) pi_user_tags during creation: %s", *spp.ID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi shared processor pool (%s) pi_user_tags during creation: %s", *spp.ID, err), "ibm_pi_shared_processor_pool", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+	return append(diags, resourceIBMPISharedProcessorPoolRead(ctx, d, meta)...)
 }
 
 func isWaitForPISharedProcessorPoolAvailable(ctx context.Context, d *schema.ResourceData, client *instance.IBMPISharedProcessorPoolClient, id string) (interface{}, error) {
@@ -367,6 +368,7 @@ func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -404,12 +406,12 @@ func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi shared processor pool (%s) pi_user_tags: %s", sppID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi shared processor pool (%s) pi_user_tags: %s", sppID, err), "ibm_pi_shared_processor_pool", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+	return append(diags, resourceIBMPISharedProcessorPoolRead(ctx, d, meta)...)
 }
 
 func detectSPPPlacementGroupChange(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string, d *schema.ResourceData, sppID string) diag.Diagnostics {
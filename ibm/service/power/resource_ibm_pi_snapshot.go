@@ -118,6 +118,7 @@ func ResourceIBMPISnapshot() *schema.Resource {
 }
 
 func resourceIBMPISnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -166,12 +167,12 @@ func resourceIBMPISnapshotCreate(ctx context.Context, d *schema.ResourceData, me
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err), "ibm_pi_snapshot", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPISnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPISnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -211,6 +212,7 @@ func resourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPISnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	log.Printf("Calling the IBM Power Snapshot update call")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -245,12 +247,12 @@ func resourceIBMPISnapshotUpdate(ctx context.Context, d *schema.ResourceData, me
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err), "ibm_pi_snapshot", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
 
-	return resourceIBMPISnapshotRead(ctx, d, meta)
+	return append(diags, resourceIBMPISnapshotRead(ctx, d, meta)...)
 }
 
 func resourceIBMPISnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -244,6 +244,7 @@ func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -327,11 +328,11 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		oldList, newList := d.GetChange(Arg_UserTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(vol.Crn), "", UserTagType)
 		if err != nil {
-			log.Printf("Error on update of volume (%s) pi_user_tags during creation: %s", volumeid, err)
+			diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of volume (%s) pi_user_tags during creation: %s", volumeid, err), "ibm_pi_volume", "create").WithAttribute(Arg_UserTags).GetDiag()...)
 		}
 	}
 
-	return resourceIBMPIVolumeRead(ctx, d, meta)
+	return append(diags, resourceIBMPIVolumeRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -393,6 +394,7 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -449,11 +451,11 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi volume (%s) pi_user_tags: %s", volumeID, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of pi volume (%s) pi_user_tags: %s", volumeID, err), "ibm_pi_volume", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
-	return resourceIBMPIVolumeRead(ctx, d, meta)
+	return append(diags, resourceIBMPIVolumeRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -243,14 +243,15 @@ func isIBMPIResourceDeleteRefreshFunc(client *instance.IBMPIWorkspacesClient, id
 }
 
 func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of workspace (%s) pi_user_tags: %s", crn, err)
+				diags = append(diags, flex.TerraformWarningf(err, fmt.Sprintf("Error on update of workspace (%s) pi_user_tags: %s", crn, err), "ibm_pi_workspace", "update").WithAttribute(Arg_UserTags).GetDiag()...)
 			}
 		}
 	}
-	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+	return append(diags, resourceIBMPIWorkspaceRead(ctx, d, meta)...)
 }
@@ -119,47 +119,16 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	name := d.Get("name").(string)
 	mostRecent := d.Get("most_recent").(bool)
 
-	resourceKeys := rc.ListResourceKeysOptions{
-		Name: &name,
-	}
-
-	keys, _, err := rsContClient.ListResourceKeys(&resourceKeys)
-	if err != nil {
-		return err
-	}
-	var filteredKeys []rc.ResourceKey
-
-	if d.Get("resource_instance_id") == "" {
-		filteredKeys = keys.Resources
-	} else {
-		crn, err := getCRN(d, meta)
+	var crn *string
+	if d.Get("resource_instance_id") != "" {
+		crn, err = getCRN(d, meta)
 		if err != nil || crn == nil {
 			return err
 		}
-		for _, key := range keys.Resources {
-			if *key.SourceCRN == *crn {
-				filteredKeys = append(filteredKeys, key)
-			}
-		}
-
-	}
-
-	if len(filteredKeys) == 0 {
-		return fmt.Errorf("[ERROR] No resource keys found with name [%s]", name)
 	}
-
-	var key rc.ResourceKey
-
-	if len(filteredKeys) > 1 {
-		if mostRecent {
-			key = mostRecentResourceKey(filteredKeys)
-		} else {
-			return fmt.Errorf("[ERROR] More than one resource key found with name matching [%s]. "+
-				"Set 'most_recent' to true in your configuration to force the most recent resource key "+
-				"to be used", name)
-		}
-	} else {
-		key = filteredKeys[0]
+	key, err := findResourceKey(rsContClient, name, crn, mostRecent)
+	if err != nil {
+		return err
 	}
 
 	d.SetId(*key.ID)
@@ -201,16 +170,12 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	// ### Modification for onetime_credientails
 	d.Set("onetime_credentials", key.OnetimeCredentials)
-	var credInterface map[string]interface{}
-	cred, _ := json.Marshal(key.Credentials)
-	json.Unmarshal(cred, &credInterface)
-	d.Set("credentials", flex.Flatten(credInterface))
-
-	creds, err := json.Marshal(key.Credentials)
+	credentials, creds, err := flattenResourceKeyCredentials(key)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+		return err
 	}
-	if err = d.Set("credentials_json", string(creds)); err != nil {
+	d.Set("credentials", credentials)
+	if err = d.Set("credentials_json", creds); err != nil {
 		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
 	}
 	d.Set("status", key.State)
@@ -218,17 +183,74 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// findResourceKey returns the resource key with the given name, of the
+// resource instance or alias with the CRN sourceCRN when it is set.
+func findResourceKey(rsContClient *rc.ResourceControllerV2, name string, sourceCRN *string, mostRecent bool) (rc.ResourceKey, error) {
+	resourceKeys := rc.ListResourceKeysOptions{
+		Name: &name,
+	}
+
+	keys, _, err := rsContClient.ListResourceKeys(&resourceKeys)
+	if err != nil {
+		return rc.ResourceKey{}, err
+	}
+	var filteredKeys []rc.ResourceKey
+
+	if sourceCRN == nil {
+		filteredKeys = keys.Resources
+	} else {
+		for _, key := range keys.Resources {
+			if *key.SourceCRN == *sourceCRN {
+				filteredKeys = append(filteredKeys, key)
+			}
+		}
+
+	}
+
+	if len(filteredKeys) == 0 {
+		return rc.ResourceKey{}, fmt.Errorf("[ERROR] No resource keys found with name [%s]", name)
+	}
+
+	if len(filteredKeys) > 1 {
+		if mostRecent {
+			return mostRecentResourceKey(filteredKeys), nil
+		}
+		return rc.ResourceKey{}, fmt.Errorf("[ERROR] More than one resource key found with name matching [%s]. "+
+			"Set 'most_recent' to true in your configuration to force the most recent resource key "+
+			"to be used", name)
+	}
+	return filteredKeys[0], nil
+}
+
+// flattenResourceKeyCredentials returns the credentials of a resource key,
+// flattened to a map and as a JSON string.
+func flattenResourceKeyCredentials(key rc.ResourceKey) (flex.Map, string, error) {
+	var credInterface map[string]interface{}
+	cred, _ := json.Marshal(key.Credentials)
+	json.Unmarshal(cred, &credInterface)
+
+	creds, err := json.Marshal(key.Credentials)
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error marshalling resource key credentials: %s", err)
+	}
+	return flex.Flatten(credInterface), string(creds), nil
+}
+
 func getCRN(d *schema.ResourceData, meta interface{}) (*string, error) {
 
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return nil, err
 	}
+	return getSourceCRN(rsConClient, d.Get("resource_instance_id").(string), d.Get("resource_alias_id").(string))
+}
 
-	if insID, ok := d.GetOk("resource_instance_id"); ok {
-		insIdString := insID.(string)
+// getSourceCRN returns the CRN of the resource instance, or else of the
+// resource alias, of a resource key.
+func getSourceCRN(rsConClient *rc.ResourceControllerV2, instanceID, aliasID string) (*string, error) {
+	if instanceID != "" {
 		resourceInstanceGet := rc.GetResourceInstanceOptions{
-			ID: &insIdString,
+			ID: &instanceID,
 		}
 		instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 		if err != nil {
@@ -237,10 +259,9 @@ func getCRN(d *schema.ResourceData, meta interface{}) (*string, error) {
 		return instance.CRN, nil
 
 	}
-	if insID, ok := d.GetOk("resource_alias_id"); ok {
-		insaliasIdString := insID.(string)
+	if aliasID != "" {
 		resourceInstanceAliasGet := rc.GetResourceAliasOptions{
-			ID: &insaliasIdString,
+			ID: &aliasID,
 		}
 		instance, resp, err := rsConClient.GetResourceAlias(&resourceInstanceAliasGet)
 		if err != nil {
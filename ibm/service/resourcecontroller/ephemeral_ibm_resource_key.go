@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &resourceKeyEphemeralResource{}
+
+// NewResourceKeyEphemeralResource returns the ibm_resource_key ephemeral
+// resource, the credentials of an existing resource key that are never
+// written to the plan or the state.
+func NewResourceKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &resourceKeyEphemeralResource{}
+}
+
+type resourceKeyEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type resourceKeyModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	ResourceInstanceID types.String `tfsdk:"resource_instance_id"`
+	ResourceAliasID    types.String `tfsdk:"resource_alias_id"`
+	MostRecent         types.Bool   `tfsdk:"most_recent"`
+	CRN                types.String `tfsdk:"crn"`
+	Credentials        types.Map    `tfsdk:"credentials"`
+	CredentialsJSON    types.String `tfsdk:"credentials_json"`
+}
+
+func (r *resourceKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_key"
+}
+
+func (r *resourceKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The credentials of a resource key, looked up by ID or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID or the CRN of the resource key. Either id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the resource key. Either id or name must be set.",
+			},
+			"resource_instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The id of the resource instance of the resource key looked up by name",
+			},
+			"resource_alias_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The id of the resource alias of the resource key looked up by name",
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional: true,
+				Description: "If true and multiple resource keys are found by name, the most recently created resource key is used. " +
+					"If false, an error is returned",
+			},
+			"crn": schema.StringAttribute{
+				Computed:    true,
+				Description: "crn of resource key",
+			},
+			"credentials": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Credentials asociated with the key",
+			},
+			"credentials_json": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Credentials asociated with the key in json string",
+			},
+		},
+	}
+}
+
+func (r *resourceKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if session, ok := req.ProviderData.(conns.ClientSession); ok {
+		r.session = session
+	}
+}
+
+func (r *resourceKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.session == nil {
+		tfErr := flex.TerraformErrorf(nil, "The provider is not configured", "(Ephemeral) ibm_resource_key", "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	var config resourceKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.getResourceKey(config)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Ephemeral) ibm_resource_key", "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	credentials, credentialsJSON, err := flattenResourceKeyCredentials(key)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Ephemeral) ibm_resource_key", "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+
+	credentialsMap, diags := types.MapValueFrom(ctx, types.StringType, map[string]string(credentials))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.ID = types.StringPointerValue(key.ID)
+	config.Name = types.StringPointerValue(key.Name)
+	config.CRN = types.StringPointerValue(key.CRN)
+	config.Credentials = credentialsMap
+	config.CredentialsJSON = types.StringValue(credentialsJSON)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r *resourceKeyEphemeralResource) getResourceKey(config resourceKeyModel) (rc.ResourceKey, error) {
+	rsContClient, err := r.session.ResourceControllerV2API()
+	if err != nil {
+		return rc.ResourceKey{}, err
+	}
+
+	if id := config.ID.ValueString(); id != "" {
+		key, resp, err := rsContClient.GetResourceKey(&rc.GetResourceKeyOptions{ID: &id})
+		if err != nil {
+			return rc.ResourceKey{}, fmt.Errorf("[ERROR] Error retrieving resource key %s: %s with resp code: %s", id, err, resp)
+		}
+		return *key, nil
+	}
+
+	name := config.Name.ValueString()
+	if name == "" {
+		return rc.ResourceKey{}, fmt.Errorf("[ERROR] Either id or name must be set")
+	}
+	crn, err := getSourceCRN(rsContClient, config.ResourceInstanceID.ValueString(), config.ResourceAliasID.ValueString())
+	if err != nil {
+		return rc.ResourceKey{}, err
+	}
+	return findResourceKey(rsContClient, name, crn, config.MostRecent.ValueBool())
+}
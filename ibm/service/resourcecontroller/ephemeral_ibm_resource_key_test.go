@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceKeyEphemeralResource_basic(t *testing.T) {
+	resourceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyEphemeralResourceConfig(resourceName, resourceKey),
+			},
+		},
+	})
+}
+
+// The ephemeral credentials are checked by the preconditions, as they are in
+// no state.
+func testAccCheckIBMResourceKeyEphemeralResourceConfig(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+
+resource "ibm_resource_instance" "resource" {
+  name     = "%s"
+  service  = "cloud-object-storage"
+  plan     = "standard"
+  location = "global"
+}
+
+resource "ibm_resource_key" "resourcekey" {
+  name                 = "%s"
+  role                 = "Writer"
+  resource_instance_id = ibm_resource_instance.resource.id
+}
+
+ephemeral "ibm_resource_key" "testacc_ephemeral_resource_key" {
+  id = ibm_resource_key.resourcekey.id
+}
+
+ephemeral "ibm_resource_key" "testacc_ephemeral_resource_key1" {
+  name                 = ibm_resource_key.resourcekey.name
+  resource_instance_id = ibm_resource_instance.resource.id
+}
+
+resource "terraform_data" "check" {
+  lifecycle {
+    precondition {
+      condition     = ephemeral.ibm_resource_key.testacc_ephemeral_resource_key.credentials["apikey"] != ""
+      error_message = "The credentials of the resource key are missing."
+    }
+    precondition {
+      condition     = ephemeral.ibm_resource_key.testacc_ephemeral_resource_key1.crn == ibm_resource_key.resourcekey.crn
+      error_message = "The resource key looked up by name does not match."
+    }
+  }
+}
+`, resourceName, resourceKey)
+
+}
@@ -629,7 +629,7 @@ func SchematicsEndpointURL(region, meta interface{}) (string, bool, error) {
 		if visibility == "private" || visibility == "public-and-private" {
 			schematicsEndpoint = fmt.Sprintf("https://%s.%s", fmt.Sprintf("private-%s.schematics", region), "cloud.ibm.com")
 		}
-		schematicsEndpointURL := conns.EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint)
+		schematicsEndpointURL := meta.(conns.ClientSession).EndpointFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint)
 		return schematicsEndpointURL, true, nil
 	}
 	return "", false, nil
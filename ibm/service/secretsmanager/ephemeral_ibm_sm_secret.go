@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const SecretEphemeralResourceName = "ibm_sm_secret"
+
+var _ ephemeral.EphemeralResourceWithConfigure = &secretEphemeralResource{}
+
+// NewSecretEphemeralResource returns the ibm_sm_secret ephemeral resource,
+// the payload of a secret of any type that is never written to the plan or
+// the state.
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &secretEphemeralResource{}
+}
+
+type secretEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type secretModel struct {
+	InstanceID      types.String `tfsdk:"instance_id"`
+	Region          types.String `tfsdk:"region"`
+	EndpointType    types.String `tfsdk:"endpoint_type"`
+	SecretID        types.String `tfsdk:"secret_id"`
+	Name            types.String `tfsdk:"name"`
+	SecretGroupName types.String `tfsdk:"secret_group_name"`
+	SecretType      types.String `tfsdk:"secret_type"`
+	Payload         types.String `tfsdk:"payload"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	ApiKey          types.String `tfsdk:"api_key"`
+	Certificate     types.String `tfsdk:"certificate"`
+	Intermediate    types.String `tfsdk:"intermediate"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+}
+
+func (r *secretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sm_secret"
+}
+
+func (r *secretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The payload of a Secrets Manager secret, looked up by ID or by name.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Secrets Manager instance.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region of the Secrets Manager instance.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "public or private.",
+			},
+			"secret_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the secret. Either secret_id or name, secret_group_name and secret_type must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The human-readable name of the secret.",
+			},
+			"secret_group_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The human-readable name of the secret group of the secret.",
+			},
+			"secret_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The secret type, such as arbitrary, kv, username_password, iam_credentials, service_credentials, imported_cert, public_cert or private_cert.",
+			},
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload of an arbitrary secret, or the data of a kv secret and the credentials of a service_credentials secret as JSON.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username of a username_password secret.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of a username_password secret.",
+			},
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key of an iam_credentials secret.",
+			},
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded certificate of a certificate secret.",
+			},
+			"intermediate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded intermediate certificate of an imported_cert or public_cert secret, or the issuing CA of a private_cert secret.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key of a certificate secret.",
+			},
+		},
+	}
+}
+
+func (r *secretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if session, ok := req.ProviderData.(conns.ClientSession); ok {
+		r.session = session
+	}
+}
+
+func (r *secretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config secretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resource := fmt.Sprintf("(Ephemeral) %s", SecretEphemeralResourceName)
+	if r.session == nil {
+		tfErr := flex.TerraformErrorf(nil, "The provider is not configured", resource, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	if config.SecretID.ValueString() == "" && config.SecretType.ValueString() == "" {
+		tfErr := flex.TerraformErrorf(nil, "secret_type must be set to look up a secret by name", resource, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+
+	locator := secretLocator{
+		InstanceID:      config.InstanceID.ValueString(),
+		Region:          config.Region.ValueString(),
+		EndpointType:    config.EndpointType.ValueString(),
+		SecretID:        config.SecretID.ValueString(),
+		Name:            config.Name.ValueString(),
+		SecretGroupName: config.SecretGroupName.ValueString(),
+	}
+	secretIntf, region, tfErr := getSecret(ctx, r.session, locator, config.SecretType.ValueString(), resource)
+	if tfErr != nil {
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	config.Region = types.StringValue(region)
+	if err := setSecretPayload(&config, secretIntf); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), resource, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag())
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// setSecretPayload sets the ID, the type and the payload of the secret.
+func setSecretPayload(model *secretModel, secretIntf secretsmanagerv2.SecretIntf) error {
+	var id, secretType *string
+	switch secret := secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecret:
+		id, secretType = secret.ID, secret.SecretType
+		model.Payload = types.StringPointerValue(secret.Payload)
+	case *secretsmanagerv2.KVSecret:
+		id, secretType = secret.ID, secret.SecretType
+		data, err := json.Marshal(secret.Data)
+		if err != nil {
+			return fmt.Errorf("Error marshalling the data of the secret: %s", err)
+		}
+		model.Payload = types.StringValue(string(data))
+	case *secretsmanagerv2.ServiceCredentialsSecret:
+		id, secretType = secret.ID, secret.SecretType
+		credentials, err := json.Marshal(secret.Credentials)
+		if err != nil {
+			return fmt.Errorf("Error marshalling the credentials of the secret: %s", err)
+		}
+		model.Payload = types.StringValue(string(credentials))
+	case *secretsmanagerv2.UsernamePasswordSecret:
+		id, secretType = secret.ID, secret.SecretType
+		model.Username = types.StringPointerValue(secret.Username)
+		model.Password = types.StringPointerValue(secret.Password)
+	case *secretsmanagerv2.IAMCredentialsSecret:
+		id, secretType = secret.ID, secret.SecretType
+		model.ApiKey = types.StringPointerValue(secret.ApiKey)
+	case *secretsmanagerv2.ImportedCertificate:
+		id, secretType = secret.ID, secret.SecretType
+		model.Certificate = types.StringPointerValue(secret.Certificate)
+		model.Intermediate = types.StringPointerValue(secret.Intermediate)
+		model.PrivateKey = types.StringPointerValue(secret.PrivateKey)
+	case *secretsmanagerv2.PublicCertificate:
+		id, secretType = secret.ID, secret.SecretType
+		model.Certificate = types.StringPointerValue(secret.Certificate)
+		model.Intermediate = types.StringPointerValue(secret.Intermediate)
+		model.PrivateKey = types.StringPointerValue(secret.PrivateKey)
+	case *secretsmanagerv2.PrivateCertificate:
+		id, secretType = secret.ID, secret.SecretType
+		model.Certificate = types.StringPointerValue(secret.Certificate)
+		model.Intermediate = types.StringPointerValue(secret.IssuingCa)
+		model.PrivateKey = types.StringPointerValue(secret.PrivateKey)
+	default:
+		return fmt.Errorf("Unsupported secret type %T", secretIntf)
+	}
+	model.SecretID = types.StringPointerValue(id)
+	model.SecretType = types.StringPointerValue(secretType)
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretEphemeralResourceConfigBasic(),
+			},
+		},
+	})
+}
+
+// The ephemeral payload is checked by the preconditions, as it is in no state.
+func testAccCheckIbmSmSecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			name = "test_ephemeral_secret_terraform"
+			instance_id   = "%s"
+  			region        = "%s"
+  			payload = "secret-credentials"
+  			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_secret" "sm_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_secret" "sm_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.name
+			secret_group_name = "default"
+			secret_type = "arbitrary"
+		}
+
+		resource "terraform_data" "check" {
+			lifecycle {
+				precondition {
+					condition     = ephemeral.ibm_sm_secret.sm_secret.payload == "secret-credentials"
+					error_message = "The payload of the secret does not match."
+				}
+				precondition {
+					condition     = ephemeral.ibm_sm_secret.sm_secret_by_name.secret_id == ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+					error_message = "The secret looked up by name does not match."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -43,10 +43,26 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 			"payload": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				Description:  "The arbitrary secret data payload.",
+			},
+			"payload_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				RequiredWith: []string{"payload_wo_version"},
+				Description:  "The arbitrary secret data payload, which is write-only and never stored in the state.",
+			},
+			"payload_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"payload_wo"},
+				Description:  "The version of payload_wo. Change it to create a new version of the secret with the value of payload_wo.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -325,9 +341,12 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting expiration_date"), ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("payload", secret.Payload); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
-		return tfErr.GetDiag()
+	// The payload is not stored in the state when it is set with payload_wo
+	if !arbitrarySecretPayloadWriteOnly(d) {
+		if err = d.Set("payload", secret.Payload); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	// Call get version metadata API to get the current version_custom_metadata
@@ -353,6 +372,17 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 	return nil
 }
 
+// arbitrarySecretPayloadWriteOnly reports whether the payload is set with
+// payload_wo. The configuration is not available on refresh, where the
+// payload_wo_version of the state tells.
+func arbitrarySecretPayloadWriteOnly(d *schema.ResourceData) bool {
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		return flex.GetWriteOnlyString(rawConfig, "payload_wo") != ""
+	}
+	rawState := d.GetRawState()
+	return !rawState.IsNull() && rawState.Type().HasAttribute("payload_wo_version") && !rawState.GetAttr("payload_wo_version").IsNull()
+}
+
 func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
@@ -425,9 +455,13 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("payload") {
+	payload := d.Get("payload").(string)
+	if d.HasChange("payload_wo_version") {
+		payload = flex.GetWriteOnlyString(d.GetRawConfig(), "payload_wo")
+	}
+	if (d.HasChange("payload") || d.HasChange("payload_wo_version")) && payload != "" {
 		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{}
-		versionModel.Payload = core.StringPtr(d.Get("payload").(string))
+		versionModel.Payload = core.StringPtr(payload)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -512,6 +546,8 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	}
 	if _, ok := d.GetOk("payload"); ok {
 		model.Payload = core.StringPtr(d.Get("payload").(string))
+	} else if payload := flex.GetWriteOnlyString(d.GetRawConfig(), "payload_wo"); payload != "" {
+		model.Payload = core.StringPtr(payload)
 	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
@@ -120,11 +120,27 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Description: "The username that is assigned to the secret.",
 			},
 			"password": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The password that is assigned to the secret.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "The password that is assigned to the secret.",
+			},
+			"password_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+				Description:   "The password that is assigned to the secret, which is write-only and never stored in the state.",
+			},
+			"password_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "The version of password_wo. Change it to create a new version of the secret with the value of password_wo.",
 			},
 			"password_generation_policy": &schema.Schema{
 				Type:        schema.TypeList,
@@ -413,9 +429,12 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting username"), UsernamePasswordSecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("password", secret.Password); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
-		return tfErr.GetDiag()
+	// The password is not stored in the state when it is set with password_wo
+	if _, ok := d.GetOk("password_wo_version"); !ok {
+		if err = d.Set("password", secret.Password); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	passwordPolicyMap, err := passwordGenerationPolicyToMap(secret.PasswordGenerationPolicy)
@@ -543,9 +562,13 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("password") {
+	password := d.Get("password").(string)
+	if d.HasChange("password_wo_version") {
+		password = flex.GetWriteOnlyString(d.GetRawConfig(), "password_wo")
+	}
+	if (d.HasChange("password") || d.HasChange("password_wo_version")) && password != "" {
 		versionModel := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}
-		versionModel.Password = core.StringPtr(d.Get("password").(string))
+		versionModel.Password = core.StringPtr(password)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -662,6 +685,8 @@ func resourceIbmSmUsernamePasswordSecretMapToSecretPrototype(d *schema.ResourceD
 	}
 	if _, ok := d.GetOk("password"); ok {
 		model.Password = core.StringPtr(d.Get("password").(string))
+	} else if password := flex.GetWriteOnlyString(d.GetRawConfig(), "password_wo"); password != "" {
+		model.Password = core.StringPtr(password)
 	}
 	if _, ok := d.GetOk("rotation"); ok {
 		RotationModel, err := resourceIbmSmUsernamePasswordSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
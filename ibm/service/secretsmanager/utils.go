@@ -60,27 +60,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getDefaultRegion(originalClient)
 	}
 }
 
+// Extract the region from the base URL of the client (provider config)
+func getDefaultRegion(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getDefaultEndpointType(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Extract the endpoint type from the base URL of the client (provider config)
+func getDefaultEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -185,19 +194,59 @@ func DateTimeToRFC3339(dt *strfmt.DateTime) (s string) {
 }
 
 func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta interface{}, secretType string, dataSourceName string) (secretsmanagerv2.SecretIntf, string, string, diag.Diagnostics) {
+	locator := secretLocator{
+		InstanceID:      d.Get("instance_id").(string),
+		SecretID:        d.Get("secret_id").(string),
+		Name:            d.Get("name").(string),
+		SecretGroupName: d.Get("secret_group_name").(string),
+	}
+	if region, ok := d.GetOk("region"); ok {
+		locator.Region = region.(string)
+	}
+	if endpointType, ok := d.GetOk("endpoint_type"); ok {
+		locator.EndpointType = endpointType.(string)
+	}
 
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
-	if err != nil {
-		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", dataSourceName), "read")
+	secretIntf, region, tfErr := getSecret(context, meta.(conns.ClientSession), locator, secretType, fmt.Sprintf("(Data) %s", dataSourceName))
+	if tfErr != nil {
 		return nil, "", "", tfErr.GetDiag()
 	}
-	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	return secretIntf, region, locator.InstanceID, nil
+}
+
+// secretLocator locates a secret of a Secrets Manager instance, by ID or by
+// name and secret group name. The region and the endpoint type default to
+// the ones of the provider configuration.
+type secretLocator struct {
+	InstanceID      string
+	Region          string
+	EndpointType    string
+	SecretID        string
+	Name            string
+	SecretGroupName string
+}
+
+// getSecret returns the secret, with its payload, and the region of its
+// instance.
+func getSecret(context context.Context, clientSession conns.ClientSession, locator secretLocator, secretType string, resource string) (secretsmanagerv2.SecretIntf, string, *flex.TerraformProblem) {
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(clientSession)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, "", resource, "read")
+	}
+	region := locator.Region
+	if region == "" {
+		region = getDefaultRegion(secretsManagerClient)
+	}
+	endpointType := locator.EndpointType
+	if endpointType == "" {
+		endpointType = getDefaultEndpointType(secretsManagerClient)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, locator.InstanceID, region, endpointType, endpointsFile)
 
-	secretId := d.Get("secret_id").(string)
-	secretName := d.Get("name").(string)
-	groupName := d.Get("secret_group_name").(string)
+	secretId := locator.SecretID
+	secretName := locator.Name
+	groupName := locator.SecretGroupName
 
 	log.Printf("[DEBUG] getSecretByIdOrByName %q %q %q %q\n", secretId, secretName, groupName, secretType)
 
@@ -211,10 +260,9 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), resource, "read")
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, region, nil
 	}
 
 	if secretName != "" && groupName != "" {
@@ -228,14 +276,12 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(context, getSecretByNameOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), resource, "read")
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, region, nil
 	}
 
-	tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-	return nil, "", "", tfErr.GetDiag()
+	return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), resource, "read")
 }
 
 func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsmanagerv2.SecretVersionMetadataPatch) (_patch map[string]interface{}, err error) {
@@ -175,7 +175,22 @@ func ResourceIBMISInstanceGroupManagerValidator() *validate.ResourceValidator {
 			MinValue:                   "1",
 			MaxValue:                   "1000"})
 
-	ibmISInstanceGroupManagerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group_manager", Schema: validateSchema}
+	crossFieldRules := []validate.CrossFieldRule{
+		{
+			RuleType:   validate.RequiredWhen,
+			Identifier: "max_membership_count",
+			When:       "manager_type",
+			WhenValues: "autoscale",
+		},
+		{
+			RuleType:        validate.NumericRelation,
+			Identifier:      "min_membership_count",
+			Operator:        "<=",
+			OtherIdentifier: "max_membership_count",
+		},
+	}
+
+	ibmISInstanceGroupManagerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group_manager", Schema: validateSchema, CrossFieldRules: crossFieldRules}
 	return &ibmISInstanceGroupManagerResourceValidator
 }
 
@@ -5,11 +5,13 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -75,6 +77,36 @@ func TestAccIBMISInstanceGroupManager_basic_scheduled(t *testing.T) {
 	})
 }
 
+func TestIBMISInstanceGroupManagerMockMembershipCount(t *testing.T) {
+	mock := unittest.NewMockServer(t)
+	config := func(membershipCounts ...string) string {
+		return fmt.Sprintf(`
+			resource "ibm_is_instance_group_manager" "instance_group_manager" {
+				name           = "tf-mock-manager"
+				instance_group = "r006-mock-instance-group"
+				manager_type   = "autoscale"
+				%s
+			}
+		`, strings.Join(membershipCounts, "\n"))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: mock.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'max_membership_count' is required when 'manager_type' is 'autoscale'`),
+			},
+			{
+				Config:      config("max_membership_count = 2", "min_membership_count = 3"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'min_membership_count' \(3\) must be less than or equal to 'max_membership_count' \(2\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceGroupManagerDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
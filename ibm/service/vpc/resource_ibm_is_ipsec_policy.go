@@ -13,7 +13,6 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,13 +40,6 @@ func ResourceIBMISIPSecPolicy() *schema.Resource {
 		Exists:        resourceIBMISIPSecPolicyExists,
 		Importer:      &schema.ResourceImporter{},
 
-		CustomizeDiff: customdiff.All(
-			customdiff.Sequence(
-				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceIPSecPolicyValidate(diff)
-				}),
-		),
-
 		Schema: map[string]*schema.Schema{
 			isIpSecName: {
 				Type:         schema.TypeString,
@@ -189,7 +181,17 @@ func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              pfs})
 
-	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema}
+	crossFieldRules := []validate.CrossFieldRule{
+		{
+			RuleType:   validate.RequiredWhen,
+			Identifier: isIpSecAuthenticationAlg,
+			Values:     "disabled",
+			When:       isIpSecEncryptionAlg,
+			WhenValues: "aes128gcm16, aes192gcm16, aes256gcm16",
+		},
+	}
+
+	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema, CrossFieldRules: crossFieldRules}
 	return &ibmISIPSECResourceValidator
 }
 
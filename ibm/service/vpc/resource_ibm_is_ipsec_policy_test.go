@@ -6,10 +6,12 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -259,6 +261,21 @@ func TestAccIBMISIPSecPolicy_Algorithms(t *testing.T) {
 	}
 }
 
+func TestIBMISIPSecPolicyMockAuthenticationAlgorithm(t *testing.T) {
+	mock := unittest.NewMockServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: mock.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISIPSecPolicyAlgorithmConfig("tfipsecc-mock", "sha256", "aes128gcm16", "group_19"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'authentication_algorithm' must be one of 'disabled' when 'encryption_algorithm' is 'aes128gcm16'`),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISIPSecPolicyAlgorithmConfig(name, authAlg, encAlg, pfs string) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_ipsec_policy" "algorithm_test" {
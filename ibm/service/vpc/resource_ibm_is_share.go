@@ -295,10 +295,11 @@ func ResourceIbmIsShare() *schema.Resource {
 				Description:  "The unique user-defined name for this file share. If unspecified, the name will be a hyphenated list of randomly-selected words.",
 			},
 			"profile": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The globally unique name for this share profile.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share", "profile"),
+				Description:  "The globally unique name for this share profile.",
 			},
 			"replica_share": &schema.Schema{
 				Type:          schema.TypeList,
@@ -1003,6 +1004,13 @@ func ResourceIbmIsShareValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             63,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "profile",
+			ValidateFunctionIdentifier: validate.ValidateNoZeroValues,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			DeprecatedValues:           "tier-3iops, tier-5iops, tier-10iops",
+		},
 		validate.ValidateSchema{
 			Identifier:                 "size",
 			ValidateFunctionIdentifier: validate.IntBetween,
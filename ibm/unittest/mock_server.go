@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//
+// Mock IBM Cloud API server used by the resource/data source unit tests.
+//
+
+// MockAccountID is the account of the user authenticated by the mock server.
+const MockAccountID = "mockaccount0000000000000000000000"
+
+// MockServer is a local stand-in for the IBM Cloud APIs. Every service of the
+// provider endpoints block (see conns.ServiceEndpointKeys) is served under
+// /<service>, e.g. the VPC API under /vpc, and IAM tokens are issued for any
+// API key. Requests are answered by the expectations registered with Expect;
+// any other request fails the test.
+type MockServer struct {
+	*httptest.Server
+
+	t            testing.TB
+	mu           sync.Mutex
+	expectations []*MockRequest
+}
+
+// MockRequest is a request expected by a MockServer and its canned response.
+type MockRequest struct {
+	service string
+	method  string
+	path    string
+
+	times int
+	calls int
+
+	status  int
+	header  http.Header
+	body    interface{}
+	respond func(r *http.Request, body []byte) (int, interface{})
+}
+
+// NewMockServer starts a mock server, which is closed when the test ends, and
+// points the service endpoints at it through the IBMCLOUD_* environment
+// variables. Tests using it cannot run in parallel.
+func NewMockServer(t testing.TB) *MockServer {
+	m := &MockServer{t: t}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Close)
+	t.Cleanup(m.assertExpectations)
+
+	for name, key := range conns.ServiceEndpointKeys {
+		t.Setenv(key, m.ServiceURL(name))
+	}
+	m.Expect("iam", http.MethodPost, "/identity/token").RespondWith(m.token)
+	return m
+}
+
+// ServiceURL returns the endpoint of the named service on the mock server.
+func (m *MockServer) ServiceURL(service string) string {
+	return m.URL + "/" + service
+}
+
+// Expect registers a request of the named service, for the method and path
+// relative to the endpoint of the service. A path segment in braces, such as
+// /v1/vpcs/{id}, matches any value. By default the expectation answers any
+// number of matching requests with an empty 200 response.
+func (m *MockServer) Expect(service, method, path string) *MockRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r := &MockRequest{service: service, method: method, path: path, status: http.StatusOK}
+	m.expectations = append(m.expectations, r)
+	return r
+}
+
+// Respond sets the status and the body, marshalled to JSON unless it is a
+// string or []byte, of the response.
+func (r *MockRequest) Respond(status int, body interface{}) *MockRequest {
+	r.status, r.body = status, body
+	return r
+}
+
+// RespondWith computes the response from the request and its body, e.g. to
+// keep the state of a mocked resource across the steps of a test.
+func (r *MockRequest) RespondWith(respond func(req *http.Request, body []byte) (int, interface{})) *MockRequest {
+	r.respond = respond
+	return r
+}
+
+// Header adds a header to the response.
+func (r *MockRequest) Header(key, value string) *MockRequest {
+	if r.header == nil {
+		r.header = http.Header{}
+	}
+	r.header.Add(key, value)
+	return r
+}
+
+// Times limits the expectation to n requests, all of which must be received
+// by the end of the test. The following matching requests fall through to the
+// next matching expectation.
+func (r *MockRequest) Times(n int) *MockRequest {
+	r.times = n
+	return r
+}
+
+func (r *MockRequest) String() string {
+	return fmt.Sprintf("%s %s%s", r.method, r.service, r.path)
+}
+
+func (r *MockRequest) matches(req *http.Request) bool {
+	if r.method != req.Method || (r.times > 0 && r.calls >= r.times) {
+		return false
+	}
+	expected := strings.Split(strings.Trim("/"+r.service+r.path, "/"), "/")
+	actual := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if expected[i] != actual[i] && !(strings.HasPrefix(expected[i], "{") && strings.HasSuffix(expected[i], "}")) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MockServer) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	m.mu.Lock()
+	var expectation *MockRequest
+	for _, r := range m.expectations {
+		if r.matches(req) {
+			expectation = r
+			r.calls++
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	if expectation == nil {
+		m.t.Errorf("unexpected request %s %s", req.Method, req.URL)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		fmt.Fprintf(w, `{"errors": [{"code": "not_mocked", "message": "No mock for %s %s"}]}`, req.Method, req.URL.Path)
+		return
+	}
+
+	status, response := expectation.status, expectation.body
+	if expectation.respond != nil {
+		status, response = expectation.respond(req, body)
+	}
+	for key, values := range expectation.header {
+		w.Header()[key] = values
+	}
+
+	var bytes []byte
+	switch response := response.(type) {
+	case nil:
+	case string:
+		bytes = []byte(response)
+	case []byte:
+		bytes = response
+	default:
+		var err error
+		if bytes, err = json.Marshal(response); err != nil {
+			m.t.Errorf("marshalling the response to %s: %s", expectation, err)
+		}
+	}
+	if len(bytes) > 0 && w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(status)
+	w.Write(bytes)
+}
+
+func (m *MockServer) assertExpectations() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.expectations {
+		if r.times > 0 && r.calls < r.times {
+			m.t.Errorf("expected %d requests %s, got %d", r.times, r, r.calls)
+		}
+	}
+}
+
+// token answers the IAM token requests with an access token for MockAccountID.
+func (m *MockServer) token(req *http.Request, body []byte) (int, interface{}) {
+	now := time.Now()
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"id":      "IBMid-mockuser",
+		"email":   "mockuser@ibm.com",
+		"account": map[string]interface{}{"bss": MockAccountID},
+		"iat":     now.Unix(),
+		"exp":     now.Add(time.Hour).Unix(),
+	}).SignedString([]byte("unittest"))
+	if err != nil {
+		m.t.Errorf("signing the mock IAM token: %s", err)
+	}
+	return http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": "mock-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    now.Add(time.Hour).Unix(),
+	}
+}
+
+// ClientSession returns a client session whose service clients send their
+// requests to the mock server.
+func (m *MockServer) ClientSession() (conns.ClientSession, error) {
+	config := conns.Config{
+		BluemixAPIKey:  "mock-api-key", // pragma: allowlist secret
+		Region:         "us-south",
+		Visibility:     "public",
+		BluemixTimeout: 30 * time.Second,
+		RetryDelay:     time.Second,
+	}
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, err
+	}
+	return session.(conns.ClientSession), nil
+}
+
+// ProviderFactories returns the provider factories to use in the
+// resource.TestCase of resource.UnitTest. The provider is configured with the
+// client session of the mock server, whatever its configuration block says.
+func (m *MockServer) ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"ibm": func() (*schema.Provider, error) {
+			p := provider.Provider()
+			p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				session, err := m.ClientSession()
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+				return session, nil
+			}
+			return p, nil
+		},
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+)
+
+func TestMockRequestMatches(t *testing.T) {
+	r := &MockRequest{service: "vpc", method: http.MethodGet, path: "/v1/vpcs/{id}"}
+	cases := map[string]bool{
+		"/vpc/v1/vpcs/r006-1234": true,
+		"/vpc/v1/vpcs":           false,
+		"/vpc/v1/subnets/r006-1": false,
+		"/iam/v1/vpcs/r006-1234": false,
+	}
+	for path, expected := range cases {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost"+path, nil)
+		if r.matches(req) != expected {
+			t.Fatalf("%s: expected match %t", path, expected)
+		}
+	}
+
+	r.Times(1).calls = 1
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/vpc/v1/vpcs/r006-1234", nil)
+	if r.matches(req) {
+		t.Fatalf("expected an exhausted expectation not to match")
+	}
+}
+
+func TestMockServerClientSession(t *testing.T) {
+	mock := NewMockServer(t)
+	mock.Expect("atracker", http.MethodGet, "/api/v2/targets").Times(1).Respond(http.StatusOK, map[string]interface{}{
+		"targets": []map[string]interface{}{{"id": "mock-target-id", "name": "mock-target"}},
+	})
+
+	session, err := mock.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	atrackerClient, err := session.AtrackerV2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	targets, _, err := atrackerClient.ListTargets(&atrackerv2.ListTargetsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets.Targets) != 1 || *targets.Targets[0].ID != "mock-target-id" {
+		t.Fatalf("unexpected targets %+v", targets.Targets)
+	}
+
+	user, err := session.BluemixUserDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.UserAccount != MockAccountID {
+		t.Fatalf("expected the mock account, got %s", user.UserAccount)
+	}
+}
+
+func TestMockServerRegionalClientSession(t *testing.T) {
+	mock := NewMockServer(t)
+
+	session, err := mock.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, region := range []string{"", "us-south"} {
+		if s, err := session.ForRegion(region); err != nil || s != session {
+			t.Fatalf("expected the session of the provider for region %q, got %v, %v", region, s, err)
+		}
+	}
+
+	euDe, err := session.ForRegion("eu-de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if euDe.Region() != "eu-de" {
+		t.Fatalf("expected the session of eu-de, got %s", euDe.Region())
+	}
+	bmxSession, err := euDe.BluemixSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bmxSession.Config.Region != "eu-de" {
+		t.Fatalf("expected the bluemix session of eu-de, got %s", bmxSession.Config.Region)
+	}
+	if s, _ := session.ForRegion("eu-de"); s != euDe {
+		t.Fatalf("expected the session of eu-de to be cached")
+	}
+	if s, _ := euDe.ForRegion("us-south"); s != session {
+		t.Fatalf("expected the session of the provider from the session of eu-de")
+	}
+	if s, _ := euDe.ForRegion("eu-de"); s != euDe {
+		t.Fatalf("expected the session of eu-de from itself")
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// enum to list the kinds of rules between the parameters of a resource.
+type CrossFieldRuleType int
+
+const (
+	// RequiredWhen requires the parameter, with one of the Values when they
+	// are set, when the condition of the rule holds.
+	RequiredWhen CrossFieldRuleType = iota
+	// ConflictsWhen forbids the parameter, or only the Values when they are
+	// set, when the condition of the rule holds.
+	ConflictsWhen
+	// NumericRelation requires the parameter to be in the relation Operator
+	// with the parameter OtherIdentifier, or with the number Value, when the
+	// condition of the rule holds.
+	NumericRelation
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t CrossFieldRuleType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t CrossFieldRuleType) String() string {
+	return [...]string{"RequiredWhen", "ConflictsWhen", "NumericRelation"}[t]
+}
+
+// The operators of the NumericRelation rules, with their description in the
+// errors.
+var crossFieldOperators = map[string]string{
+	"<":  "less than",
+	"<=": "less than or equal to",
+	"==": "equal to",
+	"!=": "different from",
+	">=": "greater than or equal to",
+	">":  "greater than",
+}
+
+// CrossFieldRule is a rule between the parameters of a resource, such as
+// "max_membership_count is required when manager_type is autoscale". A nested
+// parameter is identified by its path, such as boot_volume.0.size.
+type CrossFieldRule struct {
+	RuleType CrossFieldRuleType
+
+	// The parameter the rule applies to.
+	Identifier string
+
+	// Comma separated list of the values of the parameter, for the
+	// RequiredWhen and ConflictsWhen rules.
+	Values string
+
+	// The operator of the NumericRelation rules, one of <, <=, ==, !=, >= and
+	// >, and the parameter or the number the parameter is compared to.
+	Operator        string
+	OtherIdentifier string
+	Value           string
+
+	// The condition of the rule: the parameter When is set, to one of the
+	// comma separated WhenValues when they are set. A value ending with *
+	// matches the values starting with its prefix. The rule always applies
+	// when When is empty.
+	When       string
+	WhenValues string
+}
+
+// crossFieldDiff is the part of schema.ResourceDiff read by the rules.
+type crossFieldDiff interface {
+	GetOk(key string) (interface{}, bool)
+	NewValueKnown(key string) bool
+}
+
+// InvokeCrossFieldValidator returns the CustomizeDiff function enforcing the
+// cross field rules of the resource resourceName, nil when it has none. All
+// the rules broken by the plan are reported together. The rules that cannot
+// be enforced, see CrossFieldRule.Validate, are returned as an error.
+func InvokeCrossFieldValidator(resourceName string) (schema.CustomizeDiffFunc, error) {
+	resourceItem, ok := validatorDict.ResourceValidatorDictionary[resourceName]
+	if !ok || resourceItem == nil || len(resourceItem.CrossFieldRules) == 0 {
+		return nil, nil
+	}
+	rules := resourceItem.CrossFieldRules
+	var errs []error
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("[ERROR] Invalid cross field rule %d of %s: %s", i, resourceName, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		return validateCrossFieldRules(rules, diff)
+	}, nil
+}
+
+// Validate returns the error of a rule that cannot be enforced, such as a
+// NumericRelation rule with an unknown operator.
+func (rule CrossFieldRule) Validate() error {
+	if rule.Identifier == "" {
+		return errors.New("the rule has no Identifier")
+	}
+	if rule.WhenValues != "" && rule.When == "" {
+		return fmt.Errorf("the rule of '%s' has WhenValues without When", rule.Identifier)
+	}
+	switch rule.RuleType {
+	case RequiredWhen, ConflictsWhen:
+		return nil
+	case NumericRelation:
+		if _, ok := crossFieldOperators[rule.Operator]; !ok {
+			return fmt.Errorf("unknown operator '%s' in the rule of '%s'", rule.Operator, rule.Identifier)
+		}
+		if (rule.OtherIdentifier == "") == (rule.Value == "") {
+			return fmt.Errorf("the rule of '%s' must have either OtherIdentifier or Value", rule.Identifier)
+		}
+		if rule.Value != "" {
+			if _, err := strconv.ParseFloat(rule.Value, 64); err != nil {
+				return fmt.Errorf("the Value '%s' of the rule of '%s' is not a number", rule.Value, rule.Identifier)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown rule type %d in the rule of '%s'", int(rule.RuleType), rule.Identifier)
+	}
+}
+
+func validateCrossFieldRules(rules []CrossFieldRule, diff crossFieldDiff) error {
+	var errs []error
+	for _, rule := range rules {
+		if err := rule.validate(diff); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validate returns the error of the rule broken by diff, nil when the rule is
+// kept or cannot be evaluated yet because a value is only known after apply.
+func (rule CrossFieldRule) validate(diff crossFieldDiff) error {
+	applies, condition := rule.applies(diff)
+	if !applies || !diff.NewValueKnown(rule.Identifier) {
+		return nil
+	}
+	v, set := diff.GetOk(rule.Identifier)
+
+	switch rule.RuleType {
+	case RequiredWhen:
+		if !set {
+			return fmt.Errorf("'%s' is required%s", rule.Identifier, condition)
+		}
+		if rule.Values != "" && !matchesValues(fmt.Sprint(v), rule.Values) {
+			return fmt.Errorf("'%s' must be one of '%s'%s, got '%v'", rule.Identifier, rule.Values, condition, v)
+		}
+	case ConflictsWhen:
+		if set && rule.Values == "" {
+			return fmt.Errorf("'%s' cannot be set%s", rule.Identifier, condition)
+		}
+		if set && matchesValues(fmt.Sprint(v), rule.Values) {
+			return fmt.Errorf("'%s' cannot be '%v'%s", rule.Identifier, v, condition)
+		}
+	case NumericRelation:
+		if !set {
+			return nil
+		}
+		other, otherName := rule.Value, rule.Value
+		if rule.OtherIdentifier != "" {
+			if !diff.NewValueKnown(rule.OtherIdentifier) {
+				return nil
+			}
+			otherValue, ok := diff.GetOk(rule.OtherIdentifier)
+			if !ok {
+				return nil
+			}
+			other, otherName = fmt.Sprint(otherValue), fmt.Sprintf("'%s' (%v)", rule.OtherIdentifier, otherValue)
+		}
+		value, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		if err != nil {
+			return fmt.Errorf("'%s' must be a number, got '%v'", rule.Identifier, v)
+		}
+		otherNumber, err := strconv.ParseFloat(other, 64)
+		if err != nil {
+			return fmt.Errorf("'%s' must be a number, got '%s'", otherName, other)
+		}
+		if holds, err := compareNumbers(value, rule.Operator, otherNumber); err != nil {
+			return fmt.Errorf("'%s': %s", rule.Identifier, err)
+		} else if !holds {
+			return fmt.Errorf("'%s' (%v) must be %s %s%s", rule.Identifier, v, crossFieldOperators[rule.Operator], otherName, condition)
+		}
+	}
+	return nil
+}
+
+// applies reports whether the condition of the rule holds in diff, with the
+// description of the condition in the errors.
+func (rule CrossFieldRule) applies(diff crossFieldDiff) (bool, string) {
+	if rule.When == "" {
+		return true, ""
+	}
+	if !diff.NewValueKnown(rule.When) {
+		return false, ""
+	}
+	v, set := diff.GetOk(rule.When)
+	if !set {
+		return false, ""
+	}
+	if rule.WhenValues == "" {
+		return true, fmt.Sprintf(" when '%s' is set", rule.When)
+	}
+	return matchesValues(fmt.Sprint(v), rule.WhenValues), fmt.Sprintf(" when '%s' is '%v'", rule.When, v)
+}
+
+// matchesValues reports whether value is one of the comma separated values,
+// a value ending with * matching the values starting with its prefix.
+func matchesValues(value, values string) bool {
+	for _, candidate := range strings.Split(values, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if prefix, ok := strings.CutSuffix(candidate, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if value == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+func compareNumbers(value float64, operator string, other float64) (bool, error) {
+	switch operator {
+	case "<":
+		return value < other, nil
+	case "<=":
+		return value <= other, nil
+	case "==":
+		return value == other, nil
+	case "!=":
+		return value != other, nil
+	case ">=":
+		return value >= other, nil
+	case ">":
+		return value > other, nil
+	default:
+		return false, fmt.Errorf("unknown operator '%s'", operator)
+	}
+}
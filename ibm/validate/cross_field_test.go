@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+// testCrossFieldDiff is a plan with the values set and the values only known
+// after apply.
+type testCrossFieldDiff struct {
+	values  map[string]interface{}
+	unknown map[string]bool
+}
+
+func (d testCrossFieldDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	return v, ok
+}
+
+func (d testCrossFieldDiff) NewValueKnown(key string) bool {
+	return !d.unknown[key]
+}
+
+func TestCrossFieldRules(t *testing.T) {
+	requiredWhenAutoscale := CrossFieldRule{
+		RuleType:   RequiredWhen,
+		Identifier: "max_membership_count",
+		When:       "manager_type",
+		WhenValues: "autoscale",
+	}
+	cases := []struct {
+		name    string
+		rule    CrossFieldRule
+		diff    testCrossFieldDiff
+		message string
+	}{
+		{
+			name:    "required when the condition holds",
+			rule:    requiredWhenAutoscale,
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"manager_type": "autoscale"}},
+			message: "'max_membership_count' is required when 'manager_type' is 'autoscale'",
+		},
+		{
+			name: "not required when the condition does not hold",
+			rule: requiredWhenAutoscale,
+			diff: testCrossFieldDiff{values: map[string]interface{}{"manager_type": "scheduled"}},
+		},
+		{
+			name: "not required without the parameter of the condition",
+			rule: requiredWhenAutoscale,
+			diff: testCrossFieldDiff{values: map[string]interface{}{}},
+		},
+		{
+			name: "required and set",
+			rule: requiredWhenAutoscale,
+			diff: testCrossFieldDiff{values: map[string]interface{}{"manager_type": "autoscale", "max_membership_count": 3}},
+		},
+		{
+			name:    "required without condition",
+			rule:    CrossFieldRule{RuleType: RequiredWhen, Identifier: "name"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{}},
+			message: "'name' is required",
+		},
+		{
+			name:    "required with one of the values",
+			rule:    CrossFieldRule{RuleType: RequiredWhen, Identifier: "protocol", Values: "tcp, udp"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"protocol": "icmp"}},
+			message: "'protocol' must be one of 'tcp, udp', got 'icmp'",
+		},
+		{
+			name: "required with one of the values, set",
+			rule: CrossFieldRule{RuleType: RequiredWhen, Identifier: "protocol", Values: "tcp, udp"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"protocol": "udp"}},
+		},
+		{
+			name:    "condition with a prefix",
+			rule:    CrossFieldRule{RuleType: RequiredWhen, Identifier: "pfs", When: "encryption_algorithm", WhenValues: "aes*"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"encryption_algorithm": "aes256"}},
+			message: "'pfs' is required when 'encryption_algorithm' is 'aes256'",
+		},
+		{
+			name: "condition with a prefix, not matching",
+			rule: CrossFieldRule{RuleType: RequiredWhen, Identifier: "pfs", When: "encryption_algorithm", WhenValues: "aes*"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"encryption_algorithm": "triple_des"}},
+		},
+		{
+			name:    "condition on a set parameter",
+			rule:    CrossFieldRule{RuleType: ConflictsWhen, Identifier: "image", When: "boot_volume"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"boot_volume": "r006-1", "image": "r006-2"}},
+			message: "'image' cannot be set when 'boot_volume' is set",
+		},
+		{
+			name: "conflicting parameter not set",
+			rule: CrossFieldRule{RuleType: ConflictsWhen, Identifier: "image", When: "boot_volume"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"boot_volume": "r006-1"}},
+		},
+		{
+			name:    "conflicting value with a prefix",
+			rule:    CrossFieldRule{RuleType: ConflictsWhen, Identifier: "authentication_algorithm", Values: "md5, sha1*"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"authentication_algorithm": "sha1_96"}},
+			message: "'authentication_algorithm' cannot be 'sha1_96'",
+		},
+		{
+			name: "other value",
+			rule: CrossFieldRule{RuleType: ConflictsWhen, Identifier: "authentication_algorithm", Values: "md5, sha1*"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"authentication_algorithm": "sha256"}},
+		},
+		{
+			name:    "numeric relation with another parameter",
+			rule:    CrossFieldRule{RuleType: NumericRelation, Identifier: "min_membership_count", Operator: "<=", OtherIdentifier: "max_membership_count"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"min_membership_count": 5, "max_membership_count": 2}},
+			message: "'min_membership_count' (5) must be less than or equal to 'max_membership_count' (2)",
+		},
+		{
+			name: "numeric relation with another parameter, kept",
+			rule: CrossFieldRule{RuleType: NumericRelation, Identifier: "min_membership_count", Operator: "<=", OtherIdentifier: "max_membership_count"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"min_membership_count": 2, "max_membership_count": 2}},
+		},
+		{
+			name: "numeric relation without the other parameter",
+			rule: CrossFieldRule{RuleType: NumericRelation, Identifier: "min_membership_count", Operator: "<=", OtherIdentifier: "max_membership_count"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"min_membership_count": 5}},
+		},
+		{
+			name:    "numeric relation with a number",
+			rule:    CrossFieldRule{RuleType: NumericRelation, Identifier: "boot_volume.0.size", Operator: ">=", Value: "100", When: "profile", WhenValues: "bx2-*"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"boot_volume.0.size": 10, "profile": "bx2-2x8"}},
+			message: "'boot_volume.0.size' (10) must be greater than or equal to 100 when 'profile' is 'bx2-2x8'",
+		},
+		{
+			name:    "numeric relation with a parameter that is not a number",
+			rule:    CrossFieldRule{RuleType: NumericRelation, Identifier: "size", Operator: ">", Value: "0"},
+			diff:    testCrossFieldDiff{values: map[string]interface{}{"size": "large"}},
+			message: "'size' must be a number, got 'large'",
+		},
+		{
+			name: "unknown parameter",
+			rule: CrossFieldRule{RuleType: ConflictsWhen, Identifier: "image", When: "boot_volume"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"boot_volume": "r006-1"}, unknown: map[string]bool{"image": true}},
+		},
+		{
+			name: "unknown parameter of the condition",
+			rule: requiredWhenAutoscale,
+			diff: testCrossFieldDiff{values: map[string]interface{}{}, unknown: map[string]bool{"manager_type": true}},
+		},
+		{
+			name: "unknown other parameter",
+			rule: CrossFieldRule{RuleType: NumericRelation, Identifier: "min_membership_count", Operator: "<=", OtherIdentifier: "max_membership_count"},
+			diff: testCrossFieldDiff{values: map[string]interface{}{"min_membership_count": 5}, unknown: map[string]bool{"max_membership_count": true}},
+		},
+	}
+	for _, tc := range cases {
+		err := tc.rule.validate(tc.diff)
+		if tc.message == "" && err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if tc.message != "" && (err == nil || err.Error() != tc.message) {
+			t.Fatalf("%s: expected the error %q, got %v", tc.name, tc.message, err)
+		}
+	}
+}
+
+func TestValidateCrossFieldRulesReportsAllRules(t *testing.T) {
+	rules := []CrossFieldRule{
+		{RuleType: RequiredWhen, Identifier: "name"},
+		{RuleType: RequiredWhen, Identifier: "resource_group"},
+		{RuleType: RequiredWhen, Identifier: "zone"},
+	}
+	err := validateCrossFieldRules(rules, testCrossFieldDiff{values: map[string]interface{}{"zone": "us-south-1"}})
+	if err == nil || !strings.Contains(err.Error(), "'name' is required") || !strings.Contains(err.Error(), "'resource_group' is required") {
+		t.Fatalf("expected the errors of the name and the resource group, got %v", err)
+	}
+}
+
+func TestCrossFieldRuleValidate(t *testing.T) {
+	valid := []CrossFieldRule{
+		{RuleType: RequiredWhen, Identifier: "name", When: "type", WhenValues: "a"},
+		{RuleType: ConflictsWhen, Identifier: "image", When: "boot_volume"},
+		{RuleType: NumericRelation, Identifier: "min", Operator: "<", OtherIdentifier: "max"},
+		{RuleType: NumericRelation, Identifier: "size", Operator: "!=", Value: "0"},
+	}
+	for _, rule := range valid {
+		if err := rule.Validate(); err != nil {
+			t.Fatalf("%+v: unexpected error: %s", rule, err)
+		}
+	}
+
+	invalid := map[string]CrossFieldRule{
+		"unknown operator":         {RuleType: NumericRelation, Identifier: "min", Operator: "=<", OtherIdentifier: "max"},
+		"no operator":              {RuleType: NumericRelation, Identifier: "min", OtherIdentifier: "max"},
+		"no comparison":            {RuleType: NumericRelation, Identifier: "min", Operator: "<"},
+		"two comparisons":          {RuleType: NumericRelation, Identifier: "min", Operator: "<", OtherIdentifier: "max", Value: "3"},
+		"value that is not number": {RuleType: NumericRelation, Identifier: "min", Operator: "<", Value: "three"},
+		"no identifier":            {RuleType: RequiredWhen},
+		"condition values only":    {RuleType: RequiredWhen, Identifier: "name", WhenValues: "a"},
+		"unknown rule type":        {RuleType: CrossFieldRuleType(42), Identifier: "name"},
+	}
+	for name, rule := range invalid {
+		if err := rule.Validate(); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestInvokeCrossFieldValidator(t *testing.T) {
+	defer SetValidatorDict(validatorDict)
+	SetValidatorDict(ValidatorDict{ResourceValidatorDictionary: map[string]*ResourceValidator{
+		"ibm_valid": {ResourceName: "ibm_valid", CrossFieldRules: []CrossFieldRule{
+			{RuleType: NumericRelation, Identifier: "min", Operator: "<=", OtherIdentifier: "max"},
+		}},
+		"ibm_invalid": {ResourceName: "ibm_invalid", CrossFieldRules: []CrossFieldRule{
+			{RuleType: NumericRelation, Identifier: "min", Operator: "=<", OtherIdentifier: "max"},
+		}},
+		"ibm_without_rules": {ResourceName: "ibm_without_rules"},
+	}})
+
+	if rules, err := InvokeCrossFieldValidator("ibm_valid"); err != nil || rules == nil {
+		t.Fatalf("expected the rules of ibm_valid, got %v", err)
+	}
+	if rules, err := InvokeCrossFieldValidator("ibm_invalid"); err == nil || rules != nil {
+		t.Fatal("expected the error of the unknown operator of ibm_invalid")
+	} else if !strings.Contains(err.Error(), "unknown operator '=<'") {
+		t.Fatalf("expected the error of the unknown operator, got %s", err)
+	}
+	for _, name := range []string{"ibm_without_rules", "ibm_unknown"} {
+		if rules, err := InvokeCrossFieldValidator(name); err != nil || rules != nil {
+			t.Fatalf("%s: expected no rules, got %v", name, err)
+		}
+	}
+}
+
+func TestCompareNumbersUnknownOperator(t *testing.T) {
+	if _, err := compareNumbers(1, "=>", 2); err == nil {
+		t.Fatal("expected the error of the unknown operator")
+	}
+	rule := CrossFieldRule{RuleType: NumericRelation, Identifier: "min", Operator: "=>", Value: "2"}
+	if err := rule.validate(testCrossFieldDiff{values: map[string]interface{}{"min": 1}}); err == nil {
+		t.Fatal("expected the error of the unknown operator")
+	}
+}
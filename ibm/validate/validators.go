@@ -1169,6 +1169,10 @@ type ValidateSchema struct {
 	MinValueLength int
 	MaxValueLength int
 
+	// Comma separated list of the values that are still accepted but
+	// deprecated, they are reported with a warning.
+	DeprecatedValues string
+
 	// Is this nullable
 	Nullable bool
 
@@ -1187,6 +1191,10 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Array of rules between the parameters of the resource, enforced on plan
+	// by InvokeCrossFieldValidator.
+	CrossFieldRules []CrossFieldRule
 }
 
 type ValidatorDict struct {
@@ -1225,7 +1233,7 @@ func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc
 	}
 
 	if found {
-		return invokeValidatorInternal(schemaToInvoke)
+		return withDeprecatedValues(resourceName, schemaToInvoke, invokeValidatorInternal(schemaToInvoke))
 	} else {
 		// Add error code later. TODO
 		return nil
@@ -1250,13 +1258,39 @@ func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaVal
 	}
 
 	if found {
-		return invokeValidatorInternal(schemaToInvoke)
+		return withDeprecatedValues(resourceName, schemaToInvoke, invokeValidatorInternal(schemaToInvoke))
 	} else {
 		// Add error code later. TODO
 		return nil
 	}
 }
 
+// withDeprecatedValues warns about the deprecated values of the parameter, in
+// addition to the validation of validateFunc.
+func withDeprecatedValues(resourceName string, vs ValidateSchema, validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	if vs.DeprecatedValues == "" {
+		return validateFunc
+	}
+	deprecatedValues := strings.Split(vs.DeprecatedValues, ",")
+	for i := range deprecatedValues {
+		deprecatedValues[i] = strings.TrimSpace(deprecatedValues[i])
+	}
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if validateFunc != nil {
+			ws, errors = validateFunc(v, k)
+		}
+		value := fmt.Sprint(v)
+		for _, deprecated := range deprecatedValues {
+			if value == deprecated {
+				summary := fmt.Sprintf("The value %q of %s is deprecated and will be removed in a future release", value, k)
+				ws = append(ws, flex.TerraformWarningf(nil, summary, resourceName, "validate").WithAttribute(k).GetConsoleMessage())
+				break
+			}
+		}
+		return
+	}
+}
+
 // the function is currently modified to invoke SchemaValidateFunc directly.
 // But in terraform, we will just return SchemaValidateFunc as shown below.. So terraform will invoke this func
 func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
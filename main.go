@@ -1,16 +1,44 @@
 package main
 
 import (
+	"context"
 	"log"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 func main() {
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
-	})
+
+	if err := run(context.Background()); err != nil {
+		log.Fatalf("[ERROR] Error serving the provider: %s", err)
+	}
+}
+
+// run serves the provider, and flushes the OpenTelemetry spans before it
+// returns.
+func run(ctx context.Context) error {
+	shutdownTracing, err := conns.StartTracing(ctx)
+	if err != nil {
+		log.Printf("[WARN] OpenTelemetry tracing is disabled: %s", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Printf("[WARN] Error flushing the OpenTelemetry spans: %s", err)
+		}
+	}()
+
+	return serve(ctx)
+}
+
+// serve serves the mux of the SDKv2 and plugin framework providers.
+func serve(ctx context.Context) error {
+	serverFactory, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		return err
+	}
+	return tf5server.Serve(provider.ProviderAddress, serverFactory)
 }